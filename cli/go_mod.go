@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/xplosunn/tenecs/codegen/codegen_golang"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// generateGoMod creates the go.mod for running a program with external go functions.
+// Packages of the go standard library need nothing, any other package needs to be found in a go module
+// in the directory of the .10x file declaring the external, or one of its parents.
+func generateGoMod(program *ast.Program) (string, error) {
+	moduleDirs := map[string]string{}
+	for _, importPath := range codegen_golang.ExternalGoImportPaths(program) {
+		if isGoStandardLibraryPath(importPath) {
+			continue
+		}
+		found := false
+		for _, external := range program.ExternalFunctions {
+			if external.Target != "go" || external.Module != importPath {
+				continue
+			}
+			modulePath, moduleDir, err := findGoModule(importPath, filepath.Dir(external.CodePoint.FileName))
+			if err != nil {
+				return "", err
+			}
+			if moduleDir != "" {
+				moduleDirs[modulePath] = moduleDir
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", errors.New("could not find a go.mod providing the package " + importPath)
+		}
+	}
+	if len(moduleDirs) == 0 {
+		return "", nil
+	}
+
+	modulePaths := []string{}
+	for modulePath, _ := range moduleDirs {
+		modulePaths = append(modulePaths, modulePath)
+	}
+	sort.Strings(modulePaths)
+
+	result := "module tenecs_program\n\ngo 1.22\n"
+	for _, modulePath := range modulePaths {
+		result += fmt.Sprintf("\nrequire %s v0.0.0\n", modulePath)
+		result += fmt.Sprintf("replace %s => %s\n", modulePath, moduleDirs[modulePath])
+	}
+	return result, nil
+}
+
+func isGoStandardLibraryPath(importPath string) bool {
+	firstElement := strings.Split(importPath, "/")[0]
+	return !strings.Contains(firstElement, ".")
+}
+
+func findGoModule(importPath string, dir string) (string, string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", err
+	}
+	for {
+		bytes, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			modulePath := goModModulePath(string(bytes))
+			if importPath == modulePath || strings.HasPrefix(importPath, modulePath+"/") {
+				return modulePath, dir, nil
+			}
+		} else if !os.IsNotExist(err) {
+			return "", "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", nil
+		}
+		dir = parent
+	}
+}
+
+func goModModulePath(goMod string) string {
+	for _, line := range strings.Split(goMod, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "module ") {
+			return strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module ")), `"`)
+		}
+	}
+	return ""
+}
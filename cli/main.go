@@ -11,12 +11,16 @@ import (
 	"github.com/xplosunn/tenecs/external/node"
 	"github.com/xplosunn/tenecs/formatter"
 	"github.com/xplosunn/tenecs/parser"
+	"github.com/xplosunn/tenecs/project"
 	"github.com/xplosunn/tenecs/typer"
+	"github.com/xplosunn/tenecs/typer/cache"
 	"github.com/xplosunn/tenecs/typer/type_error"
+	"golang.org/x/exp/slices"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 )
 
@@ -26,6 +30,8 @@ func main() {
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(testCmd)
 
+	testCmd.Flags().Int64("seed", 0, "seed for runtime.random, to reproduce a previous run")
+
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -81,37 +87,57 @@ var formatCmd = &cobra.Command{
 }
 
 var runCmd = &cobra.Command{
-	Use:   "run [FILE]",
+	Use:   "run [FILE] [-- ARGS...]",
 	Short: "Run the code",
-	Long:  ``,
+	Long:  `Runs the code in FILE. The ARGS after -- are passed on to the program.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		programArgs := []string{}
+		if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+			programArgs = args[dash:]
+			args = args[:dash]
+		}
 		if len(args) == 0 {
 			return errors.New("Please provide a file")
 		}
 
 		filePath := args[0]
-		compileAndRun(false, filePath)
+		compileAndRun(false, filePath, programArgs, []string{})
 		return nil
 	},
 }
 
 var testCmd = &cobra.Command{
-	Use:   "test [FILE]",
+	Use:   "test [PATH]",
 	Short: "Run the tests",
-	Long:  ``,
+	Long:  `Runs the tests in PATH, a file or directory, which defaults to the current directory.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return errors.New("Please provide a file")
+		filePath := testPath(args)
+		// the seed goes in the environment, so that it isn't one of the args the tests see
+		testEnv := []string{}
+		if cmd.Flags().Changed("seed") {
+			seed, err := cmd.Flags().GetInt64("seed")
+			if err != nil {
+				return err
+			}
+			testEnv = append(testEnv, "TENECS_SEED="+strconv.FormatInt(seed, 10))
 		}
-
-		filePath := args[0]
-		compileAndRun(true, filePath)
+		compileAndRun(true, filePath, []string{}, testEnv)
 		return nil
 	},
 }
 
-func compileAndRun(testMode bool, filePath string) {
-	files, err := getFiles(filePath)
+func compileAndRun(testMode bool, filePath string, programArgs []string, env []string) {
+	targetFiles, err := getFiles(filePath)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	targetFiles, err = relativeToWorkingDir(targetFiles)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	files, err := getProjectFiles(filePath, targetFiles)
 	if err != nil {
 		fmt.Println(err.Error())
 		return
@@ -138,7 +164,7 @@ func compileAndRun(testMode bool, filePath string) {
 		}
 		desugaredFiles[filePath] = desugared
 	}
-	ast, err := typer.TypecheckPackages(desugaredFiles)
+	ast, err := typer.TypecheckPackagesWithCache(desugaredFiles, fileContents, typecheckCache())
 	if err != nil {
 		typecheckError := err.(*type_error.TypecheckError)
 		rendered, err2 := type_error.Render(fileContents[typecheckError.File], typecheckError)
@@ -149,12 +175,22 @@ func compileAndRun(testMode bool, filePath string) {
 		fmt.Println(rendered)
 		return
 	}
+	goMod, err := generateGoMod(ast)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
 	if testMode {
-		foundTests := codegen.FindTests(ast)
+		err = codegen_golang.UnavailableFunctionsError(ast)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		foundTests := codegen.FilterTestsDeclaredIn(ast, codegen.FindTests(ast), targetFiles)
 		generated := codegen_golang.GenerateProgramTest(ast, foundTests)
-		runGo(generated)
+		runGo(generated, goMod, programArgs, env)
 	} else {
-		foundRunnables := codegen.FindRunnables(ast)
+		foundRunnables := codegen.FilterRunnablesDeclaredIn(ast, codegen.FindRunnables(ast), targetFiles)
 		if len(foundRunnables.GoMain) > 1 ||
 			len(foundRunnables.WebWebApp) > 1 ||
 			(len(foundRunnables.GoMain) > 0 && len(foundRunnables.WebWebApp) > 0) {
@@ -162,13 +198,28 @@ func compileAndRun(testMode bool, filePath string) {
 			return
 		} else if len(foundRunnables.GoMain) > 0 {
 			targetMain := foundRunnables.GoMain[0]
+			err = codegen_golang.UnavailableFunctionsError(ast)
+			if err != nil {
+				fmt.Println(err.Error())
+				return
+			}
 			generated := codegen_golang.GenerateProgramMain(ast, targetMain)
-			runGo(generated)
+			runGo(generated, goMod, programArgs, env)
 		} else if len(foundRunnables.WebWebApp) > 0 {
 			target := foundRunnables.WebWebApp[0]
 
+			externalJsFiles := map[string]string{}
+			for _, path := range codegen_js.ExternalJsFilePaths(ast) {
+				bytes, err := os.ReadFile(path)
+				if err != nil {
+					fmt.Println(err.Error())
+					return
+				}
+				externalJsFiles[path] = string(bytes)
+			}
+
 			cssFiles, err := func() ([]string, error) {
-				programJs := codegen_js.GenerateProgramNonRunnable(ast)
+				programJs := codegen_js.GenerateExternalJsModules(externalJsFiles) + codegen_js.GenerateProgramNonRunnable(ast)
 				js := codegen_js.NodeProgramToPrintWebAppExternalGenerate(target.Package, programJs, target.Name)
 				jsOutput, err := node.RunCodeBlockingAndReturningOutputWhenFinished(nil, js)
 				if err != nil {
@@ -185,7 +236,7 @@ func compileAndRun(testMode bool, filePath string) {
 				return
 			}
 
-			html := codegen_js.GenerateHtmlPageForWebApp(ast, target, cssFiles)
+			html := codegen_js.GenerateHtmlPageForWebApp(ast, target, cssFiles, externalJsFiles)
 			runWebApp(html)
 		} else {
 			fmt.Println("no runnables found")
@@ -194,6 +245,15 @@ func compileAndRun(testMode bool, filePath string) {
 	}
 }
 
+// testPath is the path given to the test command, or the current directory when there's none,
+// which lets a project be tested from its root.
+func testPath(args []string) string {
+	if len(args) == 0 {
+		return "."
+	}
+	return args[0]
+}
+
 func getFiles(path string) ([]string, error) {
 	info, err := os.Stat(path)
 	if err != nil {
@@ -222,7 +282,67 @@ func getFiles(path string) ([]string, error) {
 	return files, nil
 }
 
-func runGo(generated string) {
+// typecheckCache is nil when the cache directory is unavailable, in which case every package is typechecked.
+func typecheckCache() typer.PackageCache {
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return nil
+	}
+	dirCache, err := cache.NewDirCache(dir)
+	if err != nil {
+		return nil
+	}
+	return dirCache
+}
+
+// getProjectFiles adds to the target files those of the project containing them (and its dependencies), if any.
+func getProjectFiles(path string, targetFiles []string) ([]string, error) {
+	proj, err := project.Find(path)
+	if err != nil {
+		return nil, err
+	}
+	if proj == nil {
+		return targetFiles, nil
+	}
+	projectFiles, err := proj.AllSourceFiles()
+	if err != nil {
+		return nil, err
+	}
+	projectFiles, err = relativeToWorkingDir(projectFiles)
+	if err != nil {
+		return nil, err
+	}
+	files := append([]string{}, targetFiles...)
+	for _, file := range projectFiles {
+		if !slices.Contains(files, file) {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+func relativeToWorkingDir(files []string) ([]string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	result := []string{}
+	for _, file := range files {
+		if !filepath.IsAbs(file) {
+			result = append(result, filepath.Clean(file))
+			continue
+		}
+		relative, err := filepath.Rel(wd, file)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, relative)
+	}
+	return result, nil
+}
+
+// runGo builds the generated program and runs it with the given args, exiting with its exit code if it fails.
+func runGo(generated string, goMod string, args []string, env []string) {
 	dir, err := os.MkdirTemp("", "")
 	if err != nil {
 		fmt.Println(err.Error())
@@ -243,12 +363,46 @@ func runGo(generated string) {
 		fmt.Println(err.Error())
 		return
 	}
-	runCmd := exec.Command("go", "run", generatedFilePath)
-	runCmd.Dir = dir
+	if goMod != "" {
+		err = os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		tidyCmd := exec.Command("go", "mod", "tidy")
+		tidyCmd.Dir = dir
+		tidyCmd.Stdout = os.Stderr
+		tidyCmd.Stderr = os.Stderr
+		err = tidyCmd.Run()
+		if err != nil {
+			fmt.Println("error resolving go modules of external functions")
+			fmt.Println(err.Error())
+			return
+		}
+	}
+	// Int is a Go int in the generated code, so it only has the 64 bits the language promises on 64-bit targets.
+	// On 32-bit targets the program still builds but Int is narrower and overflows sooner.
+	buildCmd := exec.Command("go", "build", "-o", "main", generatedFilePath)
+	buildCmd.Dir = dir
+	buildCmd.Stdout = os.Stdout
+	buildCmd.Stderr = os.Stderr
+	err = buildCmd.Run()
+	if err != nil {
+		fmt.Println("error building " + generatedFilePath)
+		fmt.Println(err.Error())
+		return
+	}
+	runCmd := exec.Command(filepath.Join(dir, "main"), args...)
+	runCmd.Env = append(os.Environ(), env...)
+	runCmd.Stdin = os.Stdin
 	runCmd.Stdout = os.Stdout
 	runCmd.Stderr = os.Stderr
 	err = runCmd.Run()
 	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
 		fmt.Println("error running " + generatedFilePath)
 		fmt.Println(err.Error())
 		return
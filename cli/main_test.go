@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/alecthomas/assert/v2"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTestPathDefaultsToWorkingDir(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "nested"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.10x"), []byte("package a\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "b.10x"), []byte("package a.nested\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte(""), 0644))
+
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	path := testPath([]string{})
+	assert.Equal(t, ".", path)
+	files, err := getFiles(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.10x", filepath.Join("nested", "b.10x")}, files)
+}
+
+func TestTestPathFromArgs(t *testing.T) {
+	assert.Equal(t, "src/main.10x", testPath([]string{"src/main.10x"}))
+}
@@ -1,6 +1,7 @@
 package codegen_golang
 
 import (
+	"errors"
 	"fmt"
 	"github.com/xplosunn/tenecs/codegen"
 	"github.com/xplosunn/tenecs/codegen/codegen_golang/standard_library"
@@ -58,6 +59,10 @@ func generate(testMode bool, program *ast.Program, targetMain *ast.Ref, foundTes
 		decs += fmt.Sprintf("var %s any = %s\n", VariableName(&structFuncName.Package, structFuncName.Name), code)
 	}
 
+	externalImports, externalFunctions := generateExternalFunctions(program)
+	decs += externalFunctions
+	allImports = append(allImports, externalImports...)
+
 	nativeFuncNames := maps.Keys(program.NativeFunctions)
 	ast.SortRefs(nativeFuncNames)
 	for _, nativeFuncName := range nativeFuncNames {
@@ -65,30 +70,34 @@ func generate(testMode bool, program *ast.Program, targetMain *ast.Ref, foundTes
 		caseNativeFunction, caseStructFunction := f.FunctionCases()
 		if caseNativeFunction != nil {
 			f := caseNativeFunction
+			if f.Unavailable != "" {
+				panic(UnavailableFunctionsError(program))
+			}
 			for _, impt := range f.Imports {
 				allImports = append(allImports, Import(impt))
 			}
 			decs += fmt.Sprintf("var %s any = %s\n", VariableName(&nativeFuncName.Package, nativeFuncName.Name), f.Code)
-		} else if caseStructFunction != nil {
-			constructorArguments := []types.FunctionArgument{}
-			for _, field := range caseStructFunction.FieldNamesSorted {
-				constructorArguments = append(constructorArguments, types.FunctionArgument{
-					Name:         field,
-					VariableType: caseStructFunction.Fields[field],
-				})
-			}
-			constructor := &types.Function{
-				Generics:   caseStructFunction.Struct.DeclaredGenerics,
-				Arguments:  constructorArguments,
-				ReturnType: caseStructFunction.Struct,
+		} else if caseStructFunction != nil && caseStructFunction.Constructor != nil {
+			for _, impt := range caseStructFunction.Constructor.Imports {
+				allImports = append(allImports, Import(impt))
 			}
-			code := GenerateStructFunction(constructor)
+			decs += fmt.Sprintf("var %s any = %s\n", VariableName(&nativeFuncName.Package, caseStructFunction.Struct.Name), caseStructFunction.Constructor.Code)
+		} else if caseStructFunction != nil {
+			code := generateStdLibStructFunction(*caseStructFunction)
 			decs += fmt.Sprintf("var %s any = %s\n", VariableName(&nativeFuncName.Package, caseStructFunction.Struct.Name), code)
 		} else {
 			panic("failed to find function")
 		}
 	}
 
+	if _, ok := program.NativeFunctions[ast.Ref{Package: "tenecs_json", Name: "jsonConverter"}]; ok {
+		derived, err := codegen.DeriveJsonTypes(program)
+		if err != nil {
+			panic(err)
+		}
+		decs += generateDerivedJsonTypes(derived)
+	}
+
 	main := ""
 
 	if !testMode {
@@ -103,6 +112,9 @@ func generate(testMode bool, program *ast.Program, targetMain *ast.Ref, foundTes
 		allImports = append(allImports, imports...)
 	}
 
+	stackTraceImports, stackTraceSupport := GenerateStackTraceSupport(program)
+	allImports = append(allImports, stackTraceImports...)
+
 	importStrings := []string{}
 	for _, importPkg := range allImports {
 		importStrings = append(importStrings, string(importPkg))
@@ -112,14 +124,101 @@ func generate(testMode bool, program *ast.Program, targetMain *ast.Ref, foundTes
 
 	imports := "import (\n"
 	for _, importPkg := range importStrings {
-		imports += fmt.Sprintf(`	"%s"`, importPkg) + "\n"
+		if strings.Contains(importPkg, `"`) {
+			imports += "	" + importPkg + "\n"
+		} else {
+			imports += fmt.Sprintf(`	"%s"`, importPkg) + "\n"
+		}
 	}
 	imports += ")\n"
 
 	stdLibStructs := GenerateStdLibStructs()
 
-	result := "package main\n\n" + imports + "\n" + decs + "\n" + stdLibStructs + "\n" + main
+	result := "package main\n\n" + imports + "\n" + decs + "\n" + stdLibStructs + "\n" + stackTraceSupport + "\n" + main
+	result += "\n" + GenerateSourceLineTable(result)
+
+	return result
+}
 
+// UnavailableFunctionsError is about the standard library functions used by the program that go can't run,
+// or nil if there are none. Only programs without any can be generated.
+func UnavailableFunctionsError(program *ast.Program) error {
+	nativeFuncNames := maps.Keys(program.NativeFunctions)
+	ast.SortRefs(nativeFuncNames)
+	message := ""
+	for _, nativeFuncName := range nativeFuncNames {
+		caseNativeFunction, _ := standard_library.Functions[nativeFuncName.Package+"_"+nativeFuncName.Name].FunctionCases()
+		if caseNativeFunction != nil && caseNativeFunction.Unavailable != "" {
+			if message != "" {
+				message += "\n"
+			}
+			message += strings.ReplaceAll(nativeFuncName.Package, "_", ".") + "." + nativeFuncName.Name + " can't be used in go: " + caseNativeFunction.Unavailable
+		}
+	}
+	if message == "" {
+		return nil
+	}
+	return errors.New(message)
+}
+
+// generateDerivedJsonTypes declares what tenecs_json_jsonConverter looks up by type name.
+func generateDerivedJsonTypes(derived map[string]codegen.DerivedJsonType) string {
+	result := `type tenecsJsonDerivedType struct {
+kind   string
+of     []string
+name   string
+fields []string
+is     func(any) bool
+get    func(any) map[string]any
+build  func(map[string]any) any
+}
+var tenecsJsonDerivedTypes = map[string]tenecsJsonDerivedType{
+`
+	for _, name := range codegen.SortedDerivedJsonTypeNames(derived) {
+		derivedType := derived[name]
+		of := []string{}
+		for _, typeName := range derivedType.Of {
+			of = append(of, strconv.Quote(typeName))
+		}
+		if derivedType.Kind != codegen.DerivedJsonStruct {
+			result += fmt.Sprintf("%s: {kind: %s, of: []string{%s}},\n", strconv.Quote(name), strconv.Quote(string(derivedType.Kind)), strings.Join(of, ", "))
+			continue
+		}
+		typeName := generateTypeName(derivedType.Struct)
+		fields := []string{}
+		getFields := ""
+		buildFields := ""
+		access := "v := value.(" + typeName + ")\n"
+		if len(derivedType.Fields) == 0 {
+			access = ""
+		}
+		for _, field := range derivedType.Fields {
+			fields = append(fields, strconv.Quote(field.Name))
+			of = append(of, strconv.Quote(field.TypeName))
+			getFields += fmt.Sprintf("%s: v.%s,\n", strconv.Quote(field.Name), VariableName(nil, field.Name))
+			buildFields += fmt.Sprintf("%s: fields[%s],\n", VariableName(nil, field.Name), strconv.Quote(field.Name))
+		}
+		result += fmt.Sprintf(`%s: {
+kind: "Struct",
+of: []string{%s},
+name: %s,
+fields: []string{%s},
+is: func(value any) bool {
+_, ok := value.(%s)
+return ok
+},
+get: func(value any) map[string]any {
+%sreturn map[string]any{
+%s}
+},
+build: func(fields map[string]any) any {
+return %s{
+%s}
+},
+},
+`, strconv.Quote(name), strings.Join(of, ", "), strconv.Quote(derivedType.Struct.Name), strings.Join(fields, ", "), typeName, access, getFields, typeName, buildFields)
+	}
+	result += "}\n"
 	return result
 }
 
@@ -171,6 +270,59 @@ func GenerateStructDefinition(structName string, structFunc *types.Function) str
 	return structDefinition
 }
 
+// generateStdLibStructFunction is like GenerateStructFunction, but the constructor may take only some of the fields.
+func generateStdLibStructFunction(structFunction standard_library.StructFunction) string {
+	if len(structFunction.ConstructorFieldNames) == len(structFunction.FieldNamesSorted) {
+		constructorArguments := []types.FunctionArgument{}
+		for _, field := range structFunction.FieldNamesSorted {
+			constructorArguments = append(constructorArguments, types.FunctionArgument{
+				Name:         field,
+				VariableType: structFunction.Fields[field],
+			})
+		}
+		return GenerateStructFunction(&types.Function{
+			Generics:   structFunction.Struct.DeclaredGenerics,
+			Arguments:  constructorArguments,
+			ReturnType: structFunction.Struct,
+		})
+	}
+	args := ""
+	for i, field := range structFunction.ConstructorFieldNames {
+		if i > 0 {
+			args += ", "
+		}
+		args += VariableName(nil, field) + " any"
+	}
+	constructor := fmt.Sprintf("func (%s) any {\n", args)
+	constructor += fmt.Sprintf("return %s {\n", generateTypeName(structFunction.Struct))
+	for _, field := range structFunction.FieldNamesSorted {
+		value := VariableName(nil, field)
+		if !slices.Contains(structFunction.ConstructorFieldNames, field) {
+			value = generateEmptyValue(structFunction.Fields[field])
+		}
+		constructor += fmt.Sprintf("%s: %s,\n", VariableName(nil, field), value)
+	}
+	constructor += "}\n"
+	constructor += "}"
+
+	return constructor
+}
+
+func generateEmptyValue(varType types.VariableType) string {
+	_, caseList, _, _, caseOr := varType.VariableTypeCases()
+	if caseList != nil {
+		return "[]any{}"
+	}
+	if caseOr != nil {
+		for _, element := range caseOr.Elements {
+			if types.VariableTypeEq(element, types.Void()) {
+				return "nil"
+			}
+		}
+	}
+	panic("no empty value for " + types.PrintableName(varType))
+}
+
 func GenerateStructFunction(structFunc *types.Function) string {
 	args := ""
 	for i, arg := range structFunc.Arguments {
@@ -218,6 +370,10 @@ func GenerateTestRunnerMain(
 	}
 	imports, runner := GenerateTestRunner()
 	return imports, fmt.Sprintf(`func main() {
+runtimeRandomSeed = time.Now().UnixNano()
+if seed, err := strconv.ParseInt(os.Getenv("TENECS_SEED"), 10, 64); err == nil {
+runtimeRandomSeed = seed
+}
 runTests([]any{%s}, []any{%s}, []any{%s})
 }
 
@@ -229,10 +385,14 @@ runTests([]any{%s}, []any{%s}, []any{%s})
 func GenerateMain(varToInvoke ast.Ref) ([]Import, string) {
 	imports, runtime := GenerateRuntime()
 	return imports, fmt.Sprintf(`func main() {
+defer func() { tenecsExitOnPanic(tenecsRecovered(recover())) }()
 r := runtime()
 %s.(tenecs_go_Main)._main.(func(any)any)(r)
 }
 
+var runtimeRandomSeed = time.Now().UnixNano()
+var runtimeRandomUsed atomic.Bool
+
 func runtime() tenecs_go_Runtime{
 return %s
 }
@@ -475,6 +635,10 @@ func GenerateLiteral(literal ast.Literal) string {
 		func(literal float64) { result = fmt.Sprintf("%f", literal) },
 		func(literal int) { result = fmt.Sprintf("%d", literal) },
 		func(literal string) { result = literal },
+		func(literal string) {
+			unquoted, _ := strconv.Unquote(literal)
+			result = strconv.QuoteRune([]rune(unquoted)[0])
+		},
 		func(literal bool) { result = strconv.FormatBool(literal) },
 		func() { result = "nil" },
 	)
@@ -512,6 +676,10 @@ func generateTypeName(varType types.VariableType) string {
 				return "string"
 			} else if caseKnownType.Name == "Int" {
 				return "int"
+			} else if caseKnownType.Name == "Float" {
+				return "float64"
+			} else if caseKnownType.Name == "Char" {
+				return "rune"
 			} else if caseKnownType.Name == "Boolean" {
 				return "bool"
 			} else {
@@ -561,6 +729,11 @@ func GenerateInvocation(invocation ast.Invocation, structTypeArgumentMatchFields
 		panic("expected function for invocation")
 	}
 
+	if derivedType, ok := codegen.JsonConverterDerivedType(invocation); ok {
+		funcArgList = "any"
+		argsCode = strconv.Quote(codegen.DerivedJsonTypeName(derivedType))
+	}
+
 	if overFunction.CodePointAsFirstArgument {
 		funcArgList = "any," + funcArgList
 		argsCode = fmt.Sprintf("\"%s:%d\", ", invocation.CodePoint.FileName, invocation.CodePoint.Line) + argsCode
@@ -619,9 +792,10 @@ func GenerateFunction(function ast.Function, structTypeArgumentMatchFields map[a
 			args += ", "
 		}
 	}
-	result := fmt.Sprintf("func (%s) any {\n", args)
+	result := fmt.Sprintf("func (%s) any {%s\n", args, generateFunctionStart())
 
 	for i, expression := range function.Block {
+		result += generateStatementLine(expression.SourceCodePoint())
 		if i == len(function.Block)-1 {
 			imports, exp := generateLastExpressionOfBlock(expression, structTypeArgumentMatchFields)
 			result += exp
@@ -633,7 +807,7 @@ func GenerateFunction(function ast.Function, structTypeArgumentMatchFields map[a
 		}
 	}
 
-	result += "}"
+	result += "}" + generateFunctionEnd()
 	return allImports, result
 }
 
@@ -395,6 +395,66 @@ blogpost:wee2
 	assert.Equal(t, expectedRunResult, output)
 }
 
+func TestGenerateAndRunMainWithExternalFunctions(t *testing.T) {
+	program := `package main
+
+import tenecs.error.Error
+import tenecs.go.Runtime
+import tenecs.go.Main
+
+external toUpper: (s: String) ~> String = go("strings", "ToUpper")
+external fields: (s: String) ~> List<String> = go("strings", "Fields")
+external joinWith: (elems: List<String>, sep: String) ~> String = go("strings", "Join")
+external atoi: (s: String) ~> Int | Error = go("strconv", "Atoi")
+external itoa: (i: Int) ~> String = go("strconv", "Itoa")
+
+parse := (s: String): String => {
+  when atoi(s) {
+    is i: Int => {
+      itoa(i)
+    }
+    is e: Error => {
+      e.message
+    }
+  }
+}
+
+app := Main(
+  main = (runtime: Runtime) => {
+    runtime.console.log(toUpper("hello"))
+    runtime.console.log(joinWith(fields("  a b   c "), "-"))
+    runtime.console.log(parse("42"))
+    runtime.console.log(parse("forty two"))
+  }
+)`
+
+	expectedRunResult := `HELLO
+a-b-c
+42
+strconv.Atoi: parsing "forty two": invalid syntax
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"strconv", "strings"}, codegen_golang.ExternalGoImportPaths(typed))
+
+	generated := codegen_golang.GenerateProgramMain(typed, ast.Ref{
+		Package: "main",
+		Name:    "app",
+	})
+	snaps.MatchStandaloneSnapshot(t, golang.Fmt(t, generated))
+
+	output := golang.RunCodeUnlessCached(t, generated)
+	assert.Equal(t, expectedRunResult, output)
+}
+
 func TestGenerateShortCircuitTwice(t *testing.T) {
 	program := testcode.ShortCircuitTwice
 
@@ -0,0 +1,163 @@
+package codegen_golang
+
+import (
+	"fmt"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"github.com/xplosunn/tenecs/typer/types"
+	"golang.org/x/exp/maps"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ExternalGoImportPaths lists the go packages the external functions of the program are bound to.
+func ExternalGoImportPaths(program *ast.Program) []string {
+	paths := map[string]bool{}
+	for _, external := range program.ExternalFunctions {
+		if external.Target == "go" {
+			paths[external.Module] = true
+		}
+	}
+	result := maps.Keys(paths)
+	sort.Strings(result)
+	return result
+}
+
+func generateExternalFunctions(program *ast.Program) ([]Import, string) {
+	refs := maps.Keys(program.ExternalFunctions)
+	ast.SortRefs(refs)
+
+	allImports := []Import{}
+	result := ""
+	for _, ref := range refs {
+		external := program.ExternalFunctions[ref]
+		if external.Target != "go" {
+			params := []string{}
+			for i, _ := range external.VariableType.Arguments {
+				params = append(params, fmt.Sprintf("arg%d any", i))
+			}
+			result += fmt.Sprintf("var %s any = func (%s) any {\npanic(%s)\n}\n", VariableName(&ref.Package, ref.Name), strings.Join(params, ", "), strconv.Quote("external "+ref.Name+" is only available in "+external.Target))
+			continue
+		}
+		imports, code := GenerateExternalFunction(external)
+		allImports = append(allImports, imports...)
+		result += fmt.Sprintf("var %s any = %s\n", VariableName(&ref.Package, ref.Name), code)
+	}
+	return allImports, result
+}
+
+// GenerateExternalFunction generates a function that converts its arguments into the go types of the bound
+// function, invokes it, and converts the result back.
+// A go function returning (T, error) is expected for a Tenecs return type of `T | Error`.
+func GenerateExternalFunction(external ast.ExternalFunction) ([]Import, string) {
+	alias := externalImportAlias(external.Module)
+	imports := []Import{Import(alias + " " + strconv.Quote(external.Module))}
+
+	params := []string{}
+	args := []string{}
+	for i, _ := range external.VariableType.Arguments {
+		param := fmt.Sprintf("arg%d", i)
+		params = append(params, param+" any")
+		args = append(args, externalToGo(param, external.VariableType.Arguments[i].VariableType, 0))
+	}
+	invocation := fmt.Sprintf("%s.%s(%s)", alias, external.Symbol, strings.Join(args, ", "))
+
+	returnType := external.VariableType.ReturnType
+	returnsError := false
+	_, _, _, _, caseOr := returnType.VariableTypeCases()
+	if caseOr != nil {
+		returnsError = true
+		for _, element := range caseOr.Elements {
+			_, _, caseKnownType, _, _ := element.VariableTypeCases()
+			if caseKnownType == nil || caseKnownType.Package != "tenecs.error" {
+				returnType = element
+			}
+		}
+	}
+	returnsVoid := types.VariableTypeEq(returnType, types.Void())
+
+	body := ""
+	if returnsError && returnsVoid {
+		body = fmt.Sprintf(`err := %s
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+return nil`, invocation)
+	} else if returnsError {
+		body = fmt.Sprintf(`value, err := %s
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+return %s`, invocation, externalFromGo("value", returnType, 0))
+	} else if returnsVoid {
+		body = fmt.Sprintf(`%s
+return nil`, invocation)
+	} else {
+		body = fmt.Sprintf(`return %s`, externalFromGo(invocation, returnType, 0))
+	}
+
+	return imports, fmt.Sprintf(`func (%s) any {
+%s
+}`, strings.Join(params, ", "), body)
+}
+
+func externalImportAlias(module string) string {
+	alias := "tenecs_external_"
+	for _, r := range module {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			alias += string(r)
+		} else {
+			alias += "_"
+		}
+	}
+	return alias
+}
+
+func externalGoTypeName(varType types.VariableType) string {
+	_, caseList, caseKnownType, _, _ := varType.VariableTypeCases()
+	if caseList != nil {
+		return "[]" + externalGoTypeName(caseList.Generic)
+	}
+	switch caseKnownType.Name {
+	case "String":
+		return "string"
+	case "Int":
+		return "int"
+	case "Float":
+		return "float64"
+	case "Boolean":
+		return "bool"
+	}
+	panic("unexpected external type " + types.PrintableName(varType))
+}
+
+func externalToGo(value string, varType types.VariableType, depth int) string {
+	_, caseList, _, _, _ := varType.VariableTypeCases()
+	if caseList == nil {
+		return fmt.Sprintf("%s.(%s)", value, externalGoTypeName(varType))
+	}
+	elem := fmt.Sprintf("elem%d", depth)
+	goType := externalGoTypeName(varType)
+	return fmt.Sprintf(`func() %s {
+result := %s{}
+for _, %s := range %s.([]any) {
+result = append(result, %s)
+}
+return result
+}()`, goType, goType, elem, value, externalToGo(elem, caseList.Generic, depth+1))
+}
+
+func externalFromGo(value string, varType types.VariableType, depth int) string {
+	_, caseList, _, _, _ := varType.VariableTypeCases()
+	if caseList == nil {
+		return value
+	}
+	elem := fmt.Sprintf("elem%d", depth)
+	return fmt.Sprintf(`func() any {
+result := []any{}
+for _, %s := range %s {
+result = append(result, %s)
+}
+return result
+}()`, elem, value, externalFromGo(elem, caseList.Generic, depth+1))
+}
@@ -10,12 +10,17 @@ import (
 func GenerateRuntime() ([]Import, string) {
 	imports := []Import{}
 
-	imports = append(imports, "fmt", "time")
-	console := ofMap("tenecs_go_Console", map[string]string{
-		"_log": function(params("Pmessage"), body(`fmt.Println(Pmessage)`)),
-	})
+	imports = append(imports, "bufio", "context", "fmt", "io", "log/slog", "math/rand", "net/http", "os", "sort", "strings", "sync", "sync/atomic", "time")
+	console := `func() tenecs_go_Console {
+stdin := bufio.NewReader(os.Stdin)
+return ` + runtimeConsole("stdin", "os.Stdout", "os.Stderr") + `
+}()`
 
 	time := ofMap("tenecs_go_Time", map[string]string{
+		"_now": function(params(), body(`return tenecs_time_Instant{
+  _epochMilliseconds: int(time.Now().UnixMilli()),
+}`)),
+		"_sleep": function(params("Pduration"), body(`time.Sleep(time.Duration(Pduration.(tenecs_time_Duration)._milliseconds.(int)) * time.Millisecond)`)),
 		"_today": function(params(), body(`t := time.Now()
 return tenecs_time_Date{
   _year: t.Year(),
@@ -25,14 +30,497 @@ return tenecs_time_Date{
 	})
 
 	runtime := ofMap("tenecs_go_Runtime", map[string]string{
-		"_console": console,
-		"_ref":     runtimeRefCreator(),
-		"_time":    time,
+		"_concurrent": runtimeConcurrent(),
+		"_console":    console,
+		"_fs":         runtimeFileSystem(),
+		"_http":       runtimeHttp("http.DefaultClient"),
+		"_logger":     runtimeLogger(),
+		"_process":    runtimeProcess(),
+		"_random":     runtimeRandom(),
+		"_ref":        runtimeRefCreator(),
+		"_time":       time,
 	})
 
 	return imports, runtime
 }
 
+// runtimeConcurrent runs each task in its own goroutine.
+// A panic inside a task is recovered and raised again by whoever awaits it.
+func runtimeConcurrent() string {
+	return ofMap("tenecs_go_Concurrent", map[string]string{
+		"_parallel": function(params("Ptasks"), body(`tasks := Ptasks.([]any)
+results := make([]any, len(tasks))
+panics := make([]any, len(tasks))
+var wg sync.WaitGroup
+for i, task := range tasks {
+wg.Add(1)
+go func(i int, task any) {
+defer wg.Done()
+defer func() {
+if err := tenecsRecovered(recover()); err != nil {
+panics[i] = err
+}
+}()
+results[i] = task.(func() any)()
+}(i, task)
+}
+wg.Wait()
+for _, p := range panics {
+if p != nil {
+panic(p)
+}
+}
+return results`)),
+		"_ref": runtimeConcurrentRef(),
+		"_spawn": function(params("Ptask"), body(`var result any
+var failure any
+done := make(chan struct{})
+go func() {
+defer close(done)
+defer func() {
+if err := tenecsRecovered(recover()); err != nil {
+failure = err
+}
+}()
+result = Ptask.(func() any)()
+}()
+return tenecs_go_Task{
+_await: func() any {
+<-done
+if failure != nil {
+panic(failure)
+}
+return result
+},
+}`)),
+	})
+}
+
+// runtimeFakeConcurrent runs each task to completion as soon as it is spawned, in order, so tests are deterministic.
+func runtimeFakeConcurrent() string {
+	return ofMap("tenecs_go_Concurrent", map[string]string{
+		"_parallel": function(params("Ptasks"), body(`results := []any{}
+for _, task := range Ptasks.([]any) {
+results = append(results, task.(func() any)())
+}
+return results`)),
+		"_ref": runtimeConcurrentRef(),
+		"_spawn": function(params("Ptask"), body(`result := Ptask.(func() any)()
+return tenecs_go_Task{
+_await: func() any {
+return result
+},
+}`)),
+	})
+}
+
+// runtimeConcurrentRef guards the value with a mutex, which is held while modify applies its function.
+func runtimeConcurrentRef() string {
+	return function(
+		params("Pvalue"),
+		body(`var ref any = Pvalue
+var mutex sync.Mutex
+return tenecs_ref_Ref{
+_get: func() any {
+mutex.Lock()
+defer mutex.Unlock()
+return ref
+},
+_set: func(value any) any {
+mutex.Lock()
+defer mutex.Unlock()
+ref = value
+return nil
+},
+_modify: func(f any) any {
+mutex.Lock()
+defer mutex.Unlock()
+ref = f.(func(any) any)(ref)
+return nil
+},
+}`),
+	)
+}
+
+// runtimeConsole reads from the *bufio.Reader named by stdin and writes to the io.Writer expressions stdout and stderr.
+func runtimeConsole(stdin string, stdout string, stderr string) string {
+	return ofMap("tenecs_go_Console", map[string]string{
+		"_error": function(params("Pmessage"), body(`fmt.Fprintln(`+stderr+`, Pmessage)`)),
+		"_log":   function(params("Pmessage"), body(`fmt.Fprintln(`+stdout+`, Pmessage)`)),
+		"_readAll": function(params(), body(`bytes, _ := io.ReadAll(`+stdin+`)
+return string(bytes)`)),
+		"_readLine": function(params(), body(`line, err := `+stdin+`.ReadString('\n')
+if err != nil && line == "" {
+return nil
+}
+line = strings.TrimSuffix(line, "\n")
+return strings.TrimSuffix(line, "\r")`)),
+	})
+}
+
+// runtimeFakeClock starts at the epoch and only moves when set, advanced or slept on.
+func runtimeFakeClock() string {
+	return `func() tenecs_test_FakeClock {
+now := 0
+return ` + ofMap("tenecs_test_FakeClock", map[string]string{
+		"_advance": function(params("Pduration"), body(`now += Pduration.(tenecs_time_Duration)._milliseconds.(int)`)),
+		"_set":     function(params("Pinstant"), body(`now = Pinstant.(tenecs_time_Instant)._epochMilliseconds.(int)`)),
+		"_time": ofMap("tenecs_go_Time", map[string]string{
+			"_now": function(params(), body(`return tenecs_time_Instant{
+  _epochMilliseconds: now,
+}`)),
+			"_sleep": function(params("Pduration"), body(`now += Pduration.(tenecs_time_Duration)._milliseconds.(int)`)),
+			"_today": function(params(), body(`t := time.UnixMilli(int64(now)).UTC()
+return tenecs_time_Date{
+  _year: t.Year(),
+  _month: int(t.Month()),
+  _day: t.Day(),
+}`)),
+		}),
+	}) + `
+}()`
+}
+
+// runtimeFakeConsole starts with an empty stdin and keeps what is written to stdout and stderr.
+func runtimeFakeConsole() string {
+	return `func() tenecs_test_FakeConsole {
+stdin := bufio.NewReader(strings.NewReader(""))
+stdout := &strings.Builder{}
+stderr := &strings.Builder{}
+return ` + ofMap("tenecs_test_FakeConsole", map[string]string{
+		"_console":  runtimeConsole("stdin", "stdout", "stderr"),
+		"_setStdin": function(params("Pinput"), body(`stdin.Reset(strings.NewReader(Pinput.(string)))`)),
+		"_stderr":   function(params(), body(`return stderr.String()`)),
+		"_stdout":   function(params(), body(`return stdout.String()`)),
+	}) + `
+}()`
+}
+
+func runtimeFileSystem() string {
+	return ofMap("tenecs_go_FileSystem", map[string]string{
+		"_delete": function(params("Ppath"), body(`err := os.Remove(Ppath.(string))
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}`)),
+		"_exists": function(params("Ppath"), body(`_, err := os.Stat(Ppath.(string))
+if err == nil {
+return true
+} else if os.IsNotExist(err) {
+return false
+} else {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}`)),
+		"_listDirectory": function(params("Ppath"), body(`entries, err := os.ReadDir(Ppath.(string))
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+names := []string{}
+for _, entry := range entries {
+names = append(names, entry.Name())
+}
+sort.Strings(names)
+result := []any{}
+for _, name := range names {
+result = append(result, name)
+}
+return result`)),
+		"_readFile": function(params("Ppath"), body(`bytes, err := os.ReadFile(Ppath.(string))
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+return string(bytes)`)),
+		"_writeFile": function(params("Ppath", "Pcontent"), body(`err := os.WriteFile(Ppath.(string), []byte(Pcontent.(string)), 0644)
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}`)),
+	})
+}
+
+// runtimeHttp makes requests with the *http.Client expression client.
+func runtimeHttp(client string) string {
+	return ofMap("tenecs_go_Http", map[string]string{
+		"_get": function(params("Purl"), body(`response, err := `+client+`.Get(Purl.(string))
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+`+runtimeHttpResponseFromGo("response"))),
+		"_post": function(params("Purl", "PcontentType", "Pbody"), body(`response, err := `+client+`.Post(Purl.(string), PcontentType.(string), strings.NewReader(Pbody.(string)))
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+`+runtimeHttpResponseFromGo("response"))),
+		"_serve": function(params("Pport", "Phandler"), body(`handler := `+runtimeHttpHandler("Phandler")+`
+err := http.ListenAndServe(fmt.Sprintf(":%d", Pport.(int)), http.HandlerFunc(handler))
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}`)),
+	})
+}
+
+// runtimeHttpFake routes every request made with it to the tenecs handler, through a local httptest server.
+// The server is passed to the func(*httptest.Server) expression onServer, which is responsible for closing it.
+func runtimeHttpFake(handler string, onServer string) string {
+	return `func() tenecs_go_Http {
+server := httptest.NewServer(http.HandlerFunc(` + runtimeHttpHandler(handler) + `))
+` + onServer + `(server)
+client := &http.Client{
+Transport: &http.Transport{
+DialContext: func(ctx context.Context, network string, addr string) (net.Conn, error) {
+return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+},
+},
+}
+fake := ` + runtimeHttp("client") + `
+fake._serve = ` + function(params("Pport", "Phandler"), body(`return tenecs_error_Error{_message: "serve is not available on a fake http", _details: []any{}}`)) + `
+return fake
+}()`
+}
+
+// runtimeHttpHandler adapts the tenecs handler to a func(http.ResponseWriter, *http.Request).
+func runtimeHttpHandler(handler string) string {
+	return `func(w http.ResponseWriter, r *http.Request) {
+body, err := io.ReadAll(r.Body)
+if err != nil {
+http.Error(w, err.Error(), http.StatusBadRequest)
+return
+}
+request := tenecs_http_Request{
+_method: r.Method,
+_path: r.URL.Path,
+_query: r.URL.RawQuery,
+_headers: ` + runtimeHttpHeadersFromGo("r.Header") + `,
+_body: string(body),
+}
+response := ` + handler + `.(func(any) any)(request).(tenecs_http_Response)
+for _, elem := range response._headers.([]any) {
+header := elem.(tenecs_http_Header)
+w.Header().Add(header._name.(string), header._value.(string))
+}
+w.WriteHeader(response._status.(int))
+w.Write([]byte(response._body.(string)))
+}`
+}
+
+func runtimeHttpResponseFromGo(response string) string {
+	return `defer ` + response + `.Body.Close()
+body, err := io.ReadAll(` + response + `.Body)
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+return tenecs_http_Response{
+_status: ` + response + `.StatusCode,
+_headers: ` + runtimeHttpHeadersFromGo(response+".Header") + `,
+_body: string(body),
+}`
+}
+
+func runtimeHttpHeadersFromGo(header string) string {
+	return `func() []any {
+names := []string{}
+for name, _ := range ` + header + ` {
+names = append(names, name)
+}
+sort.Strings(names)
+headers := []any{}
+for _, name := range names {
+for _, value := range ` + header + `[name] {
+headers = append(headers, tenecs_http_Header{_name: name, _value: value})
+}
+}
+return headers
+}()`
+}
+
+// runtimeLogger writes to stderr through log/slog.
+// TENECS_LOG_FORMAT=json switches from text to JSON output and TENECS_LOG_LEVEL (debug, info, warn or error) sets the minimum level, info by default.
+func runtimeLogger() string {
+	logAt := func(level string) string {
+		return function(params("Pmessage", "Pfields"), body(`logger.Log(context.Background(), `+level+`, Pmessage.(string), attrs(Pfields)...)`))
+	}
+	return `func() tenecs_go_Logger {
+level := slog.LevelInfo
+switch strings.ToLower(os.Getenv("TENECS_LOG_LEVEL")) {
+case "debug":
+level = slog.LevelDebug
+case "warn":
+level = slog.LevelWarn
+case "error":
+level = slog.LevelError
+}
+options := &slog.HandlerOptions{Level: level}
+var handler slog.Handler = slog.NewTextHandler(os.Stderr, options)
+if strings.ToLower(os.Getenv("TENECS_LOG_FORMAT")) == "json" {
+handler = slog.NewJSONHandler(os.Stderr, options)
+}
+attrs := func(fields any) []any {
+result := []any{}
+for _, field := range fields.([]any) {
+result = append(result, slog.Any(field.(tenecs_go_LogField)._key.(string), field.(tenecs_go_LogField)._value))
+}
+return result
+}
+var loggerOf func(logger *slog.Logger) tenecs_go_Logger
+loggerOf = func(logger *slog.Logger) tenecs_go_Logger {
+return ` + ofMap("tenecs_go_Logger", map[string]string{
+		"_debug": logAt("slog.LevelDebug"),
+		"_error": logAt("slog.LevelError"),
+		"_info":  logAt("slog.LevelInfo"),
+		"_warn":  logAt("slog.LevelWarn"),
+		"_with": `func(Pfields any) any {
+return loggerOf(logger.With(attrs(Pfields)...))
+}`,
+	}) + `
+}
+return loggerOf(slog.New(handler))
+}()`
+}
+
+func runtimeProcess() string {
+	return ofMap("tenecs_go_Process", map[string]string{
+		"_args": function(params(), body(`result := []any{}
+for _, arg := range os.Args[1:] {
+result = append(result, arg)
+}
+return result`)),
+		"_env": function(params("Pname"), body(`value, ok := os.LookupEnv(Pname.(string))
+if ok {
+return value
+}`)),
+		"_exit": function(params("Pcode"), body(`os.Stdout.Sync()
+os.Stderr.Sync()
+os.Exit(Pcode.(int))`)),
+	})
+}
+
+// runtimeFakeProcess starts with no args and an empty environment.
+// Exiting only records the code, the first one when exiting more than once, so the test carries on.
+func runtimeFakeProcess() string {
+	return `func() tenecs_test_FakeProcess {
+args := []any{}
+env := map[string]string{}
+var exitCode any
+return ` + ofMap("tenecs_test_FakeProcess", map[string]string{
+		"_exitCode": function(params(), body(`return exitCode`)),
+		"_process": ofMap("tenecs_go_Process", map[string]string{
+			"_args": function(params(), body(`return args`)),
+			"_env": function(params("Pname"), body(`value, ok := env[Pname.(string)]
+if ok {
+return value
+}`)),
+			"_exit": function(params("Pcode"), body(`if exitCode == nil {
+exitCode = Pcode
+}`)),
+		}),
+		"_setArgs": function(params("Pargs"), body(`args = Pargs.([]any)`)),
+		"_setEnv":  function(params("Pname", "Pvalue"), body(`env[Pname.(string)] = Pvalue.(string)`)),
+	}) + `
+}()`
+}
+
+// runtimeFakeFileSystem keeps the files in memory, with directories existing implicitly while they contain files.
+func runtimeFakeFileSystem() string {
+	return `func() tenecs_go_FileSystem {
+files := map[string]string{}
+isDirectory := func(path string) bool {
+for file, _ := range files {
+if strings.HasPrefix(file, path + "/") || path == "." {
+return true
+}
+}
+return false
+}
+return ` + ofMap("tenecs_go_FileSystem", map[string]string{
+		"_delete": function(params("Ppath"), body(`path := filepath.Clean(Ppath.(string))
+if _, ok := files[path]; !ok {
+return tenecs_error_Error{_message: "remove " + Ppath.(string) + ": no such file or directory", _details: []any{}}
+}
+delete(files, path)`)),
+		"_exists": function(params("Ppath"), body(`path := filepath.Clean(Ppath.(string))
+_, ok := files[path]
+return ok || isDirectory(path)`)),
+		"_listDirectory": function(params("Ppath"), body(`path := filepath.Clean(Ppath.(string))
+if !isDirectory(path) {
+return tenecs_error_Error{_message: "open " + Ppath.(string) + ": no such file or directory", _details: []any{}}
+}
+names := []string{}
+for file, _ := range files {
+relative, err := filepath.Rel(path, file)
+if err != nil || strings.HasPrefix(relative, "..") {
+continue
+}
+name := strings.Split(relative, "/")[0]
+if !slices.Contains(names, name) {
+names = append(names, name)
+}
+}
+sort.Strings(names)
+result := []any{}
+for _, name := range names {
+result = append(result, name)
+}
+return result`)),
+		"_readFile": function(params("Ppath"), body(`content, ok := files[filepath.Clean(Ppath.(string))]
+if !ok {
+return tenecs_error_Error{_message: "open " + Ppath.(string) + ": no such file or directory", _details: []any{}}
+}
+return content`)),
+		"_writeFile": function(params("Ppath", "Pcontent"), body(`path := filepath.Clean(Ppath.(string))
+if isDirectory(path) {
+return tenecs_error_Error{_message: "open " + Ppath.(string) + ": is a directory", _details: []any{}}
+}
+files[path] = Pcontent.(string)`)),
+	}) + `
+}()`
+}
+
+// runtimeRandom draws from a source seeded with runtimeRandomSeed, which the program using the runtime needs to declare.
+// Using it sets runtimeRandomUsed, so that the seed is only worth reporting if it played a part.
+// Both are shared by the goroutines of parallel and spawn, so the source is locked and the flag atomic.
+// int draws a uint64 until it's below the last multiple of the width of the range, so every Int in the range is as likely,
+// even when the range is wider than the largest Int.
+func runtimeRandom() string {
+	return `func() tenecs_go_Random {
+source := rand.New(rand.NewSource(runtimeRandomSeed))
+lock := sync.Mutex{}
+return ` + ofMap("tenecs_go_Random", map[string]string{
+		"_float": function(params(), body(`runtimeRandomUsed.Store(true)
+lock.Lock()
+defer lock.Unlock()
+return source.Float64()`)),
+		"_int": function(params("Pfrom", "Puntil"), body(`runtimeRandomUsed.Store(true)
+from := Pfrom.(int)
+until := Puntil.(int)
+if until <= from {
+return tenecs_error_Error{_message: fmt.Sprintf("random int needs from < until but got %d and %d", from, until), _details: []any{}}
+}
+width := uint64(until) - uint64(from)
+limit := ^uint64(0) - ^uint64(0)%width
+lock.Lock()
+defer lock.Unlock()
+for {
+drawn := source.Uint64()
+if drawn < limit {
+return from + int(drawn%width)
+}
+}`)),
+		"_shuffle": function(params("Plist"), body(`runtimeRandomUsed.Store(true)
+result := append([]any{}, Plist.([]any)...)
+lock.Lock()
+defer lock.Unlock()
+source.Shuffle(len(result), func(i int, j int) {
+result[i], result[j] = result[j], result[i]
+})
+return result`)),
+		"_uuid": function(params(), body(`runtimeRandomUsed.Store(true)
+b := make([]byte, 16)
+lock.Lock()
+source.Read(b)
+lock.Unlock()
+b[6] = (b[6] & 0x0f) | 0x40
+b[8] = (b[8] & 0x3f) | 0x80
+return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])`)),
+	}) + `
+}()`
+}
+
 func runtimeRefCreator() string {
 	return ofMap("tenecs_ref_RefCreator", map[string]string{
 		"_new": function(
@@ -8,6 +8,7 @@ import (
 	"github.com/xplosunn/tenecs/parser"
 	"github.com/xplosunn/tenecs/typer"
 	"github.com/xplosunn/tenecs/typer/ast"
+	"regexp"
 	"testing"
 )
 
@@ -46,3 +47,328 @@ app := Main(
 	output := golang.RunCodeUnlessCached(t, generated)
 	assert.Equal(t, expectedRunResult, output)
 }
+
+func TestFileSystem(t *testing.T) {
+	program := `package main
+
+import tenecs.go.Runtime
+import tenecs.go.Main
+import tenecs.error.Error
+
+app := Main(
+  main = (runtime: Runtime) => {
+    fs := runtime.fs
+    printError := (result: Void | Error): Void => {
+      when result {
+        is Void => {
+          null
+        }
+        is e: Error => {
+          runtime.console.log(e.message)
+        }
+      }
+    }
+    printError(fs.writeFile("notes.txt", "write tests"))
+    when fs.readFile("notes.txt") {
+      is content: String => {
+        runtime.console.log(content)
+      }
+      is e: Error => {
+        runtime.console.log(e.message)
+      }
+    }
+    when fs.exists("notes.txt") {
+      is exists: Boolean => {
+        runtime.console.log(if exists { "exists" } else { "missing" })
+      }
+      is e: Error => {
+        runtime.console.log(e.message)
+      }
+    }
+    printError(fs.delete("notes.txt"))
+    when fs.exists("notes.txt") {
+      is exists: Boolean => {
+        runtime.console.log(if exists { "exists" } else { "missing" })
+      }
+      is e: Error => {
+        runtime.console.log(e.message)
+      }
+    }
+    when fs.readFile("notes.txt") {
+      is content: String => {
+        runtime.console.log(content)
+      }
+      is e: Error => {
+        runtime.console.log(e.message)
+      }
+    }
+  }
+)`
+	expectedRunResult := `write tests
+exists
+missing
+open notes.txt: no such file or directory
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramMain(typed, ast.Ref{
+		Package: "main",
+		Name:    "app",
+	})
+
+	output := golang.RunCodeUnlessCached(t, generated)
+	assert.Equal(t, expectedRunResult, output)
+}
+
+func TestProcess(t *testing.T) {
+	t.Setenv("TENECS_TEST_GREETING", "hello")
+	program := `package main
+
+import tenecs.go.Runtime
+import tenecs.go.Main
+import tenecs.compare.eq
+import tenecs.list.length
+
+app := Main(
+  main = (runtime: Runtime) => {
+    when runtime.process.env("TENECS_TEST_GREETING") {
+      is greeting: String => {
+        runtime.console.log(greeting)
+      }
+      is Void => {
+        runtime.console.log("no greeting")
+      }
+    }
+    when runtime.process.env("TENECS_TEST_UNSET") {
+      is value: String => {
+        runtime.console.log(value)
+      }
+      is Void => {
+        runtime.console.log("unset")
+      }
+    }
+    if eq(0, length(runtime.process.args())) {
+      runtime.console.log("no args")
+    }
+    runtime.process.exit(0)
+    runtime.console.log("after exit")
+  }
+)`
+	expectedRunResult := `hello
+unset
+no args
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramMain(typed, ast.Ref{
+		Package: "main",
+		Name:    "app",
+	})
+
+	output := golang.RunCodeUnlessCached(t, generated)
+	assert.Equal(t, expectedRunResult, output)
+}
+
+func TestConsole(t *testing.T) {
+	program := `package main
+
+import tenecs.go.Runtime
+import tenecs.go.Main
+
+app := Main(
+  main = (runtime: Runtime) => {
+    when runtime.console.readLine() {
+      is line: String => {
+        runtime.console.log(line)
+      }
+      is Void => {
+        runtime.console.error("no input")
+      }
+    }
+    runtime.console.log(runtime.console.readAll())
+  }
+)`
+	expectedRunResult := `no input
+
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramMain(typed, ast.Ref{
+		Package: "main",
+		Name:    "app",
+	})
+
+	output := golang.RunCodeUnlessCached(t, generated)
+	assert.Equal(t, expectedRunResult, output)
+}
+
+func TestHttpServeInvalidPort(t *testing.T) {
+	program := `package main
+
+import tenecs.go.Runtime
+import tenecs.go.Main
+import tenecs.error.Error
+import tenecs.http.Request
+import tenecs.http.Response
+import tenecs.http.ok
+
+app := Main(
+  main = (runtime: Runtime) => {
+    when runtime.http.serve(-1, (request: Request): Response => ok("hello")) {
+      is Void => {
+        runtime.console.log("stopped")
+      }
+      is e: Error => {
+        runtime.console.log(e.message)
+      }
+    }
+  }
+)`
+	expectedRunResult := `listen tcp: address -1: invalid port
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramMain(typed, ast.Ref{
+		Package: "main",
+		Name:    "app",
+	})
+
+	output := golang.RunCodeUnlessCached(t, generated)
+	assert.Equal(t, expectedRunResult, output)
+}
+
+const loggerProgram = `package main
+
+import tenecs.go.Runtime
+import tenecs.go.LogField
+import tenecs.go.Main
+
+app := Main(
+  main = (runtime: Runtime) => {
+    logger := runtime.logger
+    logger.debug("starting", [])
+    logger.info("listening", [LogField("port", 8080), LogField("secure", false)])
+    requestLogger := logger.with([LogField("requestId", "abc")])
+    requestLogger.warn("slow request", [LogField("seconds", 1.5)])
+    requestLogger.error("request failed", [])
+  }
+)`
+
+func TestLoggerText(t *testing.T) {
+	t.Setenv("TENECS_LOG_FORMAT", "")
+	t.Setenv("TENECS_LOG_LEVEL", "")
+	expectedRunResult := `level=INFO msg=listening port=8080 secure=false
+level=WARN msg="slow request" requestId=abc seconds=1.5
+level=ERROR msg="request failed" requestId=abc
+`
+
+	parsed, err := parser.ParseString(loggerProgram)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramMain(typed, ast.Ref{
+		Package: "main",
+		Name:    "app",
+	})
+
+	output, err := golang.RunCodeBlockingAndReturningOutputWhenFinished(generated)
+	assert.NoError(t, err)
+	output = regexp.MustCompile(`time=\S+ `).ReplaceAllString(output, "")
+	assert.Equal(t, expectedRunResult, output)
+}
+
+func TestLoggerJson(t *testing.T) {
+	t.Setenv("TENECS_LOG_FORMAT", "json")
+	t.Setenv("TENECS_LOG_LEVEL", "debug")
+	expectedRunResult := `{"level":"DEBUG","msg":"starting"}
+{"level":"INFO","msg":"listening","port":8080,"secure":false}
+{"level":"WARN","msg":"slow request","requestId":"abc","seconds":1.5}
+{"level":"ERROR","msg":"request failed","requestId":"abc"}
+`
+
+	parsed, err := parser.ParseString(loggerProgram)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramMain(typed, ast.Ref{
+		Package: "main",
+		Name:    "app",
+	})
+
+	output, err := golang.RunCodeBlockingAndReturningOutputWhenFinished(generated)
+	assert.NoError(t, err)
+	output = regexp.MustCompile(`"time":"[^"]*",`).ReplaceAllString(output, "")
+	assert.Equal(t, expectedRunResult, output)
+}
+
+func TestWebHttpIsUnavailable(t *testing.T) {
+	program := `package main
+
+import tenecs.error.Error
+import tenecs.go.Runtime
+import tenecs.go.Main
+import tenecs.http.Response
+import tenecs.web.httpGet
+
+app := Main(
+  main = (runtime: Runtime) => {
+    httpGet("http://localhost", (result: Response | Error): Void => {
+      runtime.console.log("done")
+    })
+  }
+)`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	err = codegen_golang.UnavailableFunctionsError(typed)
+	assert.EqualError(t, err, "tenecs.web.httpGet can't be used in go: only available in web apps")
+}
@@ -0,0 +1,214 @@
+package codegen_golang
+
+import (
+	"fmt"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GenerateStackTraceSupport generates the code that translates panics of the generated program into
+// stack traces pointing at the .10x sources.
+//
+// Panics are only recovered where the program starts running Tenecs code (main, each test and each spawned task),
+// which then maps the frames of runtime.Callers through the line table appended by GenerateSourceLineTable.
+func GenerateStackTraceSupport(program *ast.Program) ([]Import, string) {
+	imports := []Import{"fmt", "os", `goruntime "runtime"`, "sort"}
+
+	refs := []ast.Ref{}
+	for ref, _ := range program.Declarations {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		a := program.Declarations[refs[i]].SourceCodePoint()
+		b := program.Declarations[refs[j]].SourceCodePoint()
+		if a.FileName != b.FileName {
+			return a.FileName < b.FileName
+		}
+		return a.Line < b.Line
+	})
+	declarations := ""
+	for _, ref := range refs {
+		codePoint := program.Declarations[ref].SourceCodePoint()
+		name := ref.Package + "." + ref.Name
+		if strings.HasPrefix(ref.Name, "syntheticName_") {
+			name = ""
+		}
+		declarations += fmt.Sprintf("{file: %s, line: %d, name: %s},\n", strconv.Quote(codePoint.FileName), codePoint.Line, strconv.Quote(name))
+	}
+
+	return imports, `type tenecsDeclaration struct {
+file string
+line int
+name string
+}
+
+// tenecsDeclarations has an empty name for declarations named _
+var tenecsDeclarations = []tenecsDeclaration{
+` + declarations + `}
+
+type tenecsStackFrame struct {
+file string
+line int
+}
+
+type tenecsRuntimeError struct {
+cause any
+frames []tenecsStackFrame
+}
+
+type tenecsTestFailure string
+
+type tenecsSourceLine struct {
+goLine int
+file string
+line int
+functionStart bool
+}
+
+var tenecsGeneratedFile = func() string {
+_, file, _, _ := goruntime.Caller(0)
+return file
+}()
+
+// tenecsSourceLineOf finds the .10x line that the given line of the generated code came from,
+// which has an empty file when it isn't generated from Tenecs code
+func tenecsSourceLineOf(goLine int) tenecsSourceLine {
+i := sort.Search(len(tenecsSourceLines), func(i int) bool {
+return tenecsSourceLines[i].goLine > goLine
+}) - 1
+if i < 0 {
+return tenecsSourceLine{}
+}
+return tenecsSourceLines[i]
+}
+
+// tenecsRecovered is meant to be called as tenecsRecovered(recover()) in a deferred function, while the stack that panicked is still there.
+// Frames are added to the ones of an already recovered error, so a task that panics has the frames of whoever awaited it too.
+func tenecsRecovered(recovered any) *tenecsRuntimeError {
+if recovered == nil {
+return nil
+}
+err, ok := recovered.(*tenecsRuntimeError)
+if !ok {
+err = &tenecsRuntimeError{cause: recovered}
+}
+pcs := make([]uintptr, 512)
+frames := goruntime.CallersFrames(pcs[:goruntime.Callers(0, pcs)])
+for {
+frame, more := frames.Next()
+// frames of inlined functions have no Func, and only functions generated from Tenecs functions are kept,
+// not the ones generated for the insides of a Tenecs expression
+if frame.File == tenecsGeneratedFile && frame.Func != nil {
+_, entryLine := frame.Func.FileLine(frame.Func.Entry())
+entry := tenecsSourceLineOf(entryLine)
+sourceLine := tenecsSourceLineOf(frame.Line)
+if sourceLine.file != "" && entry.goLine == entryLine && entry.functionStart {
+err.frames = append(err.frames, tenecsStackFrame{file: sourceLine.file, line: sourceLine.line})
+}
+}
+if !more {
+break
+}
+}
+return err
+}
+
+func tenecsDeclarationName(frame tenecsStackFrame, anonymousName string) string {
+name := "<unknown>"
+for _, declaration := range tenecsDeclarations {
+if declaration.file == frame.file && declaration.line <= frame.line {
+name = declaration.name
+}
+}
+if name == "" {
+return anonymousName
+}
+return name
+}
+
+func tenecsPanicMessage(err *tenecsRuntimeError, anonymousName string) string {
+if failure, ok := err.cause.(tenecsTestFailure); ok {
+return string(failure)
+}
+result := fmt.Sprint(err.cause)
+for _, frame := range err.frames {
+result += fmt.Sprintf("\n  at %s (%s:%d)", tenecsDeclarationName(frame, anonymousName), frame.file, frame.line)
+}
+return result
+}
+
+func tenecsExitOnPanic(err *tenecsRuntimeError) {
+if err == nil {
+return
+}
+fmt.Fprintln(os.Stderr, "runtime error: " + tenecsPanicMessage(err, "<anonymous>"))
+os.Exit(2)
+}
+`
+}
+
+var sourceLineMarker = regexp.MustCompile(`/\*tenecs:(function|end|line ("[^"]*") ([0-9]+))\*/`)
+
+// GenerateSourceLineTable maps each line of the generated code to the .10x line it came from, going by the markers
+// that GenerateFunction leaves in it. It has to be appended to the end, so the lines it maps don't move.
+func GenerateSourceLineTable(code string) string {
+	type sourceLine struct {
+		file string
+		line int
+	}
+	stack := []sourceLine{}
+	current := func() sourceLine {
+		if len(stack) == 0 {
+			return sourceLine{}
+		}
+		return stack[len(stack)-1]
+	}
+
+	entries := ""
+	previous := sourceLine{}
+	for i, goLine := range strings.Split(code, "\n") {
+		mapped := current()
+		functionStart := false
+		startsWithLine := strings.HasPrefix(strings.TrimLeft(goLine, " \t"), "/*tenecs:line ")
+		for j, match := range sourceLineMarker.FindAllStringSubmatch(goLine, -1) {
+			switch {
+			case match[1] == "function":
+				stack = append(stack, current())
+				functionStart = true
+			case match[1] == "end":
+				stack = stack[:len(stack)-1]
+			default:
+				file, _ := strconv.Unquote(match[2])
+				line, _ := strconv.Atoi(match[3])
+				stack[len(stack)-1] = sourceLine{file: file, line: line}
+				if j == 0 && startsWithLine {
+					mapped = current()
+				}
+			}
+		}
+		if functionStart {
+			mapped = current()
+		}
+		if mapped != previous || functionStart {
+			entries += fmt.Sprintf("{goLine: %d, file: %s, line: %d, functionStart: %t},\n", i+1, strconv.Quote(mapped.file), mapped.line, functionStart)
+			previous = mapped
+		}
+	}
+
+	return "var tenecsSourceLines = []tenecsSourceLine{\n" + entries + "}\n"
+}
+
+func generateFunctionStart() string {
+	return "/*tenecs:function*/"
+}
+
+func generateFunctionEnd() string {
+	return "/*tenecs:end*/"
+}
+
+func generateStatementLine(codePoint ast.CodePoint) string {
+	return fmt.Sprintf("/*tenecs:line %s %d*/", strconv.Quote(codePoint.FileName), codePoint.Line)
+}
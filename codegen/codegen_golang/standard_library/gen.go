@@ -16,6 +16,8 @@ type Function interface {
 type NativeFunction struct {
 	Imports []string
 	Code    string
+	// Unavailable is why the function can't be used in go, in which case there's no Code
+	Unavailable string
 }
 
 func (f NativeFunction) sealedFunction() {}
@@ -25,9 +27,12 @@ func (f NativeFunction) FunctionCases() (*NativeFunction, *StructFunction) {
 }
 
 type StructFunction struct {
-	Struct           *types.KnownType
-	Fields           map[string]types.VariableType
-	FieldNamesSorted []string
+	Struct                *types.KnownType
+	Fields                map[string]types.VariableType
+	FieldNamesSorted      []string
+	ConstructorFieldNames []string
+	// Constructor replaces the generated constructor, for structs that need more than their fields set
+	Constructor *NativeFunction
 }
 
 func (f StructFunction) sealedFunction() {}
@@ -67,6 +72,12 @@ return nil
 	}
 }
 
+func unavailable(reason string) NativeFunction {
+	return NativeFunction{
+		Unavailable: reason,
+	}
+}
+
 func imports(i ...string) func(*RuntimeFunction) {
 	return func(runtimeFunction *RuntimeFunction) {
 		runtimeFunction.Imports = i
@@ -87,8 +98,15 @@ func body(b string) func(*RuntimeFunction) {
 
 func structFunction(structWithFields *standard_library.StructWithFields) Function {
 	return StructFunction{
-		Struct:           structWithFields.Struct,
-		Fields:           structWithFields.Fields,
-		FieldNamesSorted: structWithFields.FieldNamesSorted,
+		Struct:                structWithFields.Struct,
+		Fields:                structWithFields.Fields,
+		FieldNamesSorted:      structWithFields.FieldNamesSorted,
+		ConstructorFieldNames: structWithFields.ConstructorFieldNames,
 	}
 }
+
+func structFunctionConstructedBy(structWithFields *standard_library.StructWithFields, constructor NativeFunction) Function {
+	result := structFunction(structWithFields).(StructFunction)
+	result.Constructor = &constructor
+	return result
+}
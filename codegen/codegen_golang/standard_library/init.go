@@ -6,16 +6,74 @@ package standard_library
 // ###############################################
 
 var Functions = map[string]Function{
+"tenecs_bigint_BigInt": tenecs_bigint_BigInt(),
+"tenecs_bigint_abs": tenecs_bigint_abs(),
+"tenecs_bigint_comparator": tenecs_bigint_comparator(),
+"tenecs_bigint_div": tenecs_bigint_div(),
+"tenecs_bigint_fromInt": tenecs_bigint_fromInt(),
+"tenecs_bigint_fromString": tenecs_bigint_fromString(),
+"tenecs_bigint_greaterThan": tenecs_bigint_greaterThan(),
+"tenecs_bigint_lessThan": tenecs_bigint_lessThan(),
+"tenecs_bigint_minus": tenecs_bigint_minus(),
+"tenecs_bigint_mod": tenecs_bigint_mod(),
+"tenecs_bigint_negate": tenecs_bigint_negate(),
+"tenecs_bigint_plus": tenecs_bigint_plus(),
+"tenecs_bigint_pow": tenecs_bigint_pow(),
+"tenecs_bigint_times": tenecs_bigint_times(),
+"tenecs_bigint_toInt": tenecs_bigint_toInt(),
+"tenecs_bigint_toString": tenecs_bigint_toString(),
 "tenecs_boolean_and": tenecs_boolean_and(),
 "tenecs_boolean_not": tenecs_boolean_not(),
 "tenecs_boolean_or": tenecs_boolean_or(),
+"tenecs_char_codePoint": tenecs_char_codePoint(),
+"tenecs_char_fromCodePoint": tenecs_char_fromCodePoint(),
+"tenecs_char_isDigit": tenecs_char_isDigit(),
+"tenecs_char_isLetter": tenecs_char_isLetter(),
+"tenecs_char_isWhitespace": tenecs_char_isWhitespace(),
+"tenecs_char_toString": tenecs_char_toString(),
+"tenecs_compare_Comparator": tenecs_compare_Comparator(),
+"tenecs_compare_Equal": tenecs_compare_Equal(),
+"tenecs_compare_Greater": tenecs_compare_Greater(),
+"tenecs_compare_Less": tenecs_compare_Less(),
+"tenecs_compare_by": tenecs_compare_by(),
+"tenecs_compare_dateComparator": tenecs_compare_dateComparator(),
 "tenecs_compare_eq": tenecs_compare_eq(),
+"tenecs_compare_floatComparator": tenecs_compare_floatComparator(),
+"tenecs_compare_intComparator": tenecs_compare_intComparator(),
+"tenecs_compare_reverse": tenecs_compare_reverse(),
+"tenecs_compare_stringComparator": tenecs_compare_stringComparator(),
+"tenecs_compare_thenBy": tenecs_compare_thenBy(),
 "tenecs_error_Error": tenecs_error_Error(),
+"tenecs_error_ErrorDetail": tenecs_error_ErrorDetail(),
+"tenecs_error_causes": tenecs_error_causes(),
+"tenecs_error_render": tenecs_error_render(),
+"tenecs_error_withCode": tenecs_error_withCode(),
+"tenecs_error_withContext": tenecs_error_withContext(),
+"tenecs_error_withDetail": tenecs_error_withDetail(),
+"tenecs_error_wrap": tenecs_error_wrap(),
+"tenecs_go_Concurrent": tenecs_go_Concurrent(),
 "tenecs_go_Console": tenecs_go_Console(),
+"tenecs_go_FileSystem": tenecs_go_FileSystem(),
+"tenecs_go_Http": tenecs_go_Http(),
+"tenecs_go_LogField": tenecs_go_LogField(),
+"tenecs_go_Logger": tenecs_go_Logger(),
 "tenecs_go_Main": tenecs_go_Main(),
+"tenecs_go_Process": tenecs_go_Process(),
+"tenecs_go_Random": tenecs_go_Random(),
 "tenecs_go_Runtime": tenecs_go_Runtime(),
+"tenecs_go_Task": tenecs_go_Task(),
 "tenecs_go_Time": tenecs_go_Time(),
+"tenecs_http_Header": tenecs_http_Header(),
+"tenecs_http_Request": tenecs_http_Request(),
+"tenecs_http_Response": tenecs_http_Response(),
+"tenecs_http_header": tenecs_http_header(),
+"tenecs_http_ok": tenecs_http_ok(),
 "tenecs_int_abs": tenecs_int_abs(),
+"tenecs_int_checkedAbs": tenecs_int_checkedAbs(),
+"tenecs_int_checkedMinus": tenecs_int_checkedMinus(),
+"tenecs_int_checkedNegate": tenecs_int_checkedNegate(),
+"tenecs_int_checkedPlus": tenecs_int_checkedPlus(),
+"tenecs_int_checkedTimes": tenecs_int_checkedTimes(),
 "tenecs_int_div": tenecs_int_div(),
 "tenecs_int_greaterThan": tenecs_int_greaterThan(),
 "tenecs_int_lessThan": tenecs_int_lessThan(),
@@ -26,11 +84,20 @@ var Functions = map[string]Function{
 "tenecs_int_ponyDiv": tenecs_int_ponyDiv(),
 "tenecs_int_ponyMod": tenecs_int_ponyMod(),
 "tenecs_int_times": tenecs_int_times(),
+"tenecs_json_JsonArray": tenecs_json_JsonArray(),
 "tenecs_json_JsonConverter": tenecs_json_JsonConverter(),
+"tenecs_json_JsonDecodeError": tenecs_json_JsonDecodeError(),
+"tenecs_json_JsonEntry": tenecs_json_JsonEntry(),
 "tenecs_json_JsonField": tenecs_json_JsonField(),
+"tenecs_json_JsonObject": tenecs_json_JsonObject(),
+"tenecs_json_decodeErrors": tenecs_json_decodeErrors(),
 "tenecs_json_jsonBoolean": tenecs_json_jsonBoolean(),
+"tenecs_json_jsonConverter": tenecs_json_jsonConverter(),
+"tenecs_json_jsonFirstError": tenecs_json_jsonFirstError(),
+"tenecs_json_jsonFloat": tenecs_json_jsonFloat(),
 "tenecs_json_jsonInt": tenecs_json_jsonInt(),
 "tenecs_json_jsonList": tenecs_json_jsonList(),
+"tenecs_json_jsonNullable": tenecs_json_jsonNullable(),
 "tenecs_json_jsonObject0": tenecs_json_jsonObject0(),
 "tenecs_json_jsonObject1": tenecs_json_jsonObject1(),
 "tenecs_json_jsonObject10": tenecs_json_jsonObject10(),
@@ -54,13 +121,24 @@ var Functions = map[string]Function{
 "tenecs_json_jsonObject7": tenecs_json_jsonObject7(),
 "tenecs_json_jsonObject8": tenecs_json_jsonObject8(),
 "tenecs_json_jsonObject9": tenecs_json_jsonObject9(),
+"tenecs_json_jsonOptional": tenecs_json_jsonOptional(),
 "tenecs_json_jsonOr": tenecs_json_jsonOr(),
 "tenecs_json_jsonString": tenecs_json_jsonString(),
+"tenecs_json_jsonValue": tenecs_json_jsonValue(),
 "tenecs_list_Break": tenecs_list_Break(),
+"tenecs_list_Group": tenecs_list_Group(),
+"tenecs_list_Indexed": tenecs_list_Indexed(),
+"tenecs_list_Pair": tenecs_list_Pair(),
+"tenecs_list_Partition": tenecs_list_Partition(),
+"tenecs_list_all": tenecs_list_all(),
+"tenecs_list_any": tenecs_list_any(),
 "tenecs_list_append": tenecs_list_append(),
 "tenecs_list_appendAll": tenecs_list_appendAll(),
 "tenecs_list_atIndexGet": tenecs_list_atIndexGet(),
 "tenecs_list_atIndexSet": tenecs_list_atIndexSet(),
+"tenecs_list_chunked": tenecs_list_chunked(),
+"tenecs_list_distinct": tenecs_list_distinct(),
+"tenecs_list_drop": tenecs_list_drop(),
 "tenecs_list_filter": tenecs_list_filter(),
 "tenecs_list_find": tenecs_list_find(),
 "tenecs_list_first": tenecs_list_first(),
@@ -68,35 +146,66 @@ var Functions = map[string]Function{
 "tenecs_list_flatten": tenecs_list_flatten(),
 "tenecs_list_fold": tenecs_list_fold(),
 "tenecs_list_forEach": tenecs_list_forEach(),
+"tenecs_list_groupBy": tenecs_list_groupBy(),
+"tenecs_list_indexed": tenecs_list_indexed(),
+"tenecs_list_last": tenecs_list_last(),
 "tenecs_list_length": tenecs_list_length(),
 "tenecs_list_map": tenecs_list_map(),
 "tenecs_list_mapNotNull": tenecs_list_mapNotNull(),
 "tenecs_list_mapUntil": tenecs_list_mapUntil(),
+"tenecs_list_max": tenecs_list_max(),
+"tenecs_list_min": tenecs_list_min(),
+"tenecs_list_partition": tenecs_list_partition(),
+"tenecs_list_range": tenecs_list_range(),
 "tenecs_list_repeat": tenecs_list_repeat(),
+"tenecs_list_sortBy": tenecs_list_sortBy(),
+"tenecs_list_sum": tenecs_list_sum(),
+"tenecs_list_take": tenecs_list_take(),
+"tenecs_list_zip": tenecs_list_zip(),
 "tenecs_ref_Ref": tenecs_ref_Ref(),
 "tenecs_ref_RefCreator": tenecs_ref_RefCreator(),
+"tenecs_regex_Match": tenecs_regex_Match(),
+"tenecs_regex_Regex": tenecs_regex_Regex(),
+"tenecs_regex_compile": tenecs_regex_compile(),
+"tenecs_regex_find": tenecs_regex_find(),
+"tenecs_regex_findAll": tenecs_regex_findAll(),
+"tenecs_regex_matches": tenecs_regex_matches(),
+"tenecs_regex_replaceAll": tenecs_regex_replaceAll(),
 "tenecs_string_characters": tenecs_string_characters(),
+"tenecs_string_chars": tenecs_string_chars(),
 "tenecs_string_contains": tenecs_string_contains(),
 "tenecs_string_endsWith": tenecs_string_endsWith(),
 "tenecs_string_firstChar": tenecs_string_firstChar(),
 "tenecs_string_firstCharCode": tenecs_string_firstCharCode(),
+"tenecs_string_fromChars": tenecs_string_fromChars(),
+"tenecs_string_fromInt": tenecs_string_fromInt(),
+"tenecs_string_graphemes": tenecs_string_graphemes(),
+"tenecs_string_indexOf": tenecs_string_indexOf(),
 "tenecs_string_isBlank": tenecs_string_isBlank(),
 "tenecs_string_isEmpty": tenecs_string_isEmpty(),
 "tenecs_string_join": tenecs_string_join(),
 "tenecs_string_length": tenecs_string_length(),
+"tenecs_string_lines": tenecs_string_lines(),
 "tenecs_string_padLeft": tenecs_string_padLeft(),
 "tenecs_string_padRight": tenecs_string_padRight(),
 "tenecs_string_repeat": tenecs_string_repeat(),
+"tenecs_string_replace": tenecs_string_replace(),
 "tenecs_string_reverse": tenecs_string_reverse(),
+"tenecs_string_split": tenecs_string_split(),
 "tenecs_string_startsWith": tenecs_string_startsWith(),
 "tenecs_string_stripPrefix": tenecs_string_stripPrefix(),
 "tenecs_string_stripSuffix": tenecs_string_stripSuffix(),
+"tenecs_string_substring": tenecs_string_substring(),
+"tenecs_string_toInt": tenecs_string_toInt(),
 "tenecs_string_toLowerCase": tenecs_string_toLowerCase(),
 "tenecs_string_toUpperCase": tenecs_string_toUpperCase(),
 "tenecs_string_trim": tenecs_string_trim(),
 "tenecs_string_trimLeft": tenecs_string_trimLeft(),
 "tenecs_string_trimRight": tenecs_string_trimRight(),
 "tenecs_test_Assert": tenecs_test_Assert(),
+"tenecs_test_FakeClock": tenecs_test_FakeClock(),
+"tenecs_test_FakeConsole": tenecs_test_FakeConsole(),
+"tenecs_test_FakeProcess": tenecs_test_FakeProcess(),
 "tenecs_test_GoIntegrationTest": tenecs_test_GoIntegrationTest(),
 "tenecs_test_GoIntegrationTestKit": tenecs_test_GoIntegrationTestKit(),
 "tenecs_test_UnitTest": tenecs_test_UnitTest(),
@@ -104,11 +213,34 @@ var Functions = map[string]Function{
 "tenecs_test_UnitTestRegistry": tenecs_test_UnitTestRegistry(),
 "tenecs_test_UnitTestSuite": tenecs_test_UnitTestSuite(),
 "tenecs_time_Date": tenecs_time_Date(),
+"tenecs_time_DateTime": tenecs_time_DateTime(),
+"tenecs_time_Duration": tenecs_time_Duration(),
+"tenecs_time_Instant": tenecs_time_Instant(),
 "tenecs_time_atStartOfMonth": tenecs_time_atStartOfMonth(),
+"tenecs_time_dayOfWeek": tenecs_time_dayOfWeek(),
+"tenecs_time_daysBetween": tenecs_time_daysBetween(),
+"tenecs_time_durationBetween": tenecs_time_durationBetween(),
+"tenecs_time_format": tenecs_time_format(),
+"tenecs_time_fromDateTime": tenecs_time_fromDateTime(),
+"tenecs_time_hours": tenecs_time_hours(),
+"tenecs_time_instantIsAfter": tenecs_time_instantIsAfter(),
+"tenecs_time_instantIsBefore": tenecs_time_instantIsBefore(),
+"tenecs_time_isAfter": tenecs_time_isAfter(),
+"tenecs_time_isBefore": tenecs_time_isBefore(),
+"tenecs_time_jsonDate": tenecs_time_jsonDate(),
+"tenecs_time_minusDuration": tenecs_time_minusDuration(),
+"tenecs_time_minutes": tenecs_time_minutes(),
+"tenecs_time_parse": tenecs_time_parse(),
 "tenecs_time_plusDays": tenecs_time_plusDays(),
+"tenecs_time_plusDuration": tenecs_time_plusDuration(),
+"tenecs_time_plusMonths": tenecs_time_plusMonths(),
 "tenecs_time_plusYears": tenecs_time_plusYears(),
+"tenecs_time_seconds": tenecs_time_seconds(),
+"tenecs_time_toDateTime": tenecs_time_toDateTime(),
 "tenecs_web_CssUrl": tenecs_web_CssUrl(),
 "tenecs_web_HtmlElement": tenecs_web_HtmlElement(),
 "tenecs_web_HtmlElementProperty": tenecs_web_HtmlElementProperty(),
 "tenecs_web_WebApp": tenecs_web_WebApp(),
+"tenecs_web_httpGet": tenecs_web_httpGet(),
+"tenecs_web_httpPost": tenecs_web_httpPost(),
 }
@@ -0,0 +1,166 @@
+package standard_library
+
+import "github.com/xplosunn/tenecs/typer/standard_library"
+
+const bigIntOfHelper = `// bigIntOf can leave out the check of SetString, as BigInt is opaque and its digits only ever come from bigIntFrom
+bigIntOf := func(value any) *big.Int {
+result, _ := new(big.Int).SetString(value.(tenecs_bigint_BigInt)._digits.(string), 10)
+return result
+}
+`
+
+const bigIntFromHelper = `bigIntFrom := func(value *big.Int) any {
+return tenecs_bigint_BigInt{
+_digits: value.String(),
+}
+}
+`
+
+const bigIntDivisionByZeroHelper = `divisionByZero := tenecs_error_Error{
+_message: "Division by zero",
+_details: []any{},
+}
+`
+
+func tenecs_bigint_BigInt() Function {
+	return structFunction(standard_library.Tenecs_bigint_BigInt)
+}
+func tenecs_bigint_fromInt() Function {
+	return function(
+		imports("math/big"),
+		params("value"),
+		body(bigIntFromHelper+`return bigIntFrom(big.NewInt(int64(value.(int))))`),
+	)
+}
+func tenecs_bigint_fromString() Function {
+	return function(
+		imports("math/big", "strconv"),
+		params("str"),
+		body(bigIntFromHelper+`result, ok := new(big.Int).SetString(str.(string), 10)
+if !ok {
+return tenecs_error_Error{
+_message: strconv.Quote(str.(string)) + " is not a BigInt",
+_details: []any{},
+}
+}
+return bigIntFrom(result)`),
+	)
+}
+func tenecs_bigint_toInt() Function {
+	return function(
+		imports("math/big"),
+		params("value"),
+		body(bigIntOfHelper+`result := bigIntOf(value)
+if !result.IsInt64() {
+return tenecs_error_Error{
+_message: result.String() + " is out of range for an Int",
+_details: []any{},
+}
+}
+return int(result.Int64())`),
+	)
+}
+func tenecs_bigint_toString() Function {
+	return function(
+		params("value"),
+		body(`return value.(tenecs_bigint_BigInt)._digits`),
+	)
+}
+func tenecs_bigint_plus() Function {
+	return function(
+		imports("math/big"),
+		params("a", "b"),
+		body(bigIntOfHelper+bigIntFromHelper+`return bigIntFrom(new(big.Int).Add(bigIntOf(a), bigIntOf(b)))`),
+	)
+}
+func tenecs_bigint_minus() Function {
+	return function(
+		imports("math/big"),
+		params("a", "b"),
+		body(bigIntOfHelper+bigIntFromHelper+`return bigIntFrom(new(big.Int).Sub(bigIntOf(a), bigIntOf(b)))`),
+	)
+}
+func tenecs_bigint_times() Function {
+	return function(
+		imports("math/big"),
+		params("a", "b"),
+		body(bigIntOfHelper+bigIntFromHelper+`return bigIntFrom(new(big.Int).Mul(bigIntOf(a), bigIntOf(b)))`),
+	)
+}
+func tenecs_bigint_div() Function {
+	return function(
+		imports("math/big"),
+		params("a", "b"),
+		body(bigIntOfHelper+bigIntFromHelper+bigIntDivisionByZeroHelper+`if bigIntOf(b).Sign() == 0 {
+return divisionByZero
+}
+return bigIntFrom(new(big.Int).Quo(bigIntOf(a), bigIntOf(b)))`),
+	)
+}
+func tenecs_bigint_mod() Function {
+	return function(
+		imports("math/big"),
+		params("a", "b"),
+		body(bigIntOfHelper+bigIntFromHelper+bigIntDivisionByZeroHelper+`if bigIntOf(b).Sign() == 0 {
+return divisionByZero
+}
+return bigIntFrom(new(big.Int).Rem(bigIntOf(a), bigIntOf(b)))`),
+	)
+}
+func tenecs_bigint_pow() Function {
+	return function(
+		imports("math/big"),
+		params("base", "exponent"),
+		body(bigIntOfHelper+bigIntFromHelper+`if exponent.(int) < 0 {
+return tenecs_error_Error{
+_message: "Exponent must not be negative",
+_details: []any{},
+}
+}
+return bigIntFrom(new(big.Int).Exp(bigIntOf(base), big.NewInt(int64(exponent.(int))), nil))`),
+	)
+}
+func tenecs_bigint_negate() Function {
+	return function(
+		imports("math/big"),
+		params("a"),
+		body(bigIntOfHelper+bigIntFromHelper+`return bigIntFrom(new(big.Int).Neg(bigIntOf(a)))`),
+	)
+}
+func tenecs_bigint_abs() Function {
+	return function(
+		imports("math/big"),
+		params("a"),
+		body(bigIntOfHelper+bigIntFromHelper+`return bigIntFrom(new(big.Int).Abs(bigIntOf(a)))`),
+	)
+}
+func tenecs_bigint_greaterThan() Function {
+	return function(
+		imports("math/big"),
+		params("a", "b"),
+		body(bigIntOfHelper+`return bigIntOf(a).Cmp(bigIntOf(b)) > 0`),
+	)
+}
+func tenecs_bigint_lessThan() Function {
+	return function(
+		imports("math/big"),
+		params("a", "b"),
+		body(bigIntOfHelper+`return bigIntOf(a).Cmp(bigIntOf(b)) < 0`),
+	)
+}
+func tenecs_bigint_comparator() Function {
+	return function(
+		imports("math/big"),
+		body(bigIntOfHelper+`return tenecs_compare_Comparator{
+	_compare: func(first any, second any) any {
+		comparison := bigIntOf(first).Cmp(bigIntOf(second))
+		if comparison < 0 {
+			return tenecs_compare_Less{}
+		} else if comparison > 0 {
+			return tenecs_compare_Greater{}
+		}
+		return tenecs_compare_Equal{}
+	},
+}`),
+	)
+}
@@ -0,0 +1,49 @@
+package standard_library
+
+func tenecs_char_codePoint() Function {
+	return function(
+		params("char"),
+		body("return int(char.(rune))"),
+	)
+}
+func tenecs_char_fromCodePoint() Function {
+	return function(
+		imports("strconv", "unicode/utf8"),
+		params("codePoint"),
+		body(`
+n := codePoint.(int)
+if n < 0 || n > utf8.MaxRune || !utf8.ValidRune(rune(n)) {
+return tenecs_error_Error{
+_message: strconv.Itoa(n) + " is not a unicode code point",
+_details: []any{},
+}
+}
+return rune(n)`),
+	)
+}
+func tenecs_char_isDigit() Function {
+	return function(
+		params("char"),
+		body("return char.(rune) >= '0' && char.(rune) <= '9'"),
+	)
+}
+func tenecs_char_isLetter() Function {
+	return function(
+		imports("unicode"),
+		params("char"),
+		body("return unicode.IsLetter(char.(rune))"),
+	)
+}
+func tenecs_char_isWhitespace() Function {
+	return function(
+		imports("unicode"),
+		params("char"),
+		body("return unicode.Is(unicode.White_Space, char.(rune))"),
+	)
+}
+func tenecs_char_toString() Function {
+	return function(
+		params("char"),
+		body("return string(char.(rune))"),
+	)
+}
@@ -1,5 +1,49 @@
 package standard_library
 
+import "github.com/xplosunn/tenecs/typer/standard_library"
+
+func tenecs_compare_Comparator() Function {
+	return structFunction(standard_library.Tenecs_compare_Comparator)
+}
+func tenecs_compare_Equal() Function {
+	return structFunction(standard_library.Tenecs_compare_Equal)
+}
+func tenecs_compare_Greater() Function {
+	return structFunction(standard_library.Tenecs_compare_Greater)
+}
+func tenecs_compare_Less() Function {
+	return structFunction(standard_library.Tenecs_compare_Less)
+}
+func tenecs_compare_by() Function {
+	return function(
+		params("field", "comparator"),
+		body(`f := field.(func(any) any)
+compare := comparator.(tenecs_compare_Comparator)._compare.(func(any, any) any)
+return tenecs_compare_Comparator{
+	_compare: func(first any, second any) any {
+		return compare(f(first), f(second))
+	},
+}`),
+	)
+}
+func tenecs_compare_dateComparator() Function {
+	return function(
+		body(`return tenecs_compare_Comparator{
+	_compare: func(first any, second any) any {
+		a := first.(tenecs_time_Date)
+		b := second.(tenecs_time_Date)
+		for _, pair := range [][]int{{a._year.(int), b._year.(int)}, {a._month.(int), b._month.(int)}, {a._day.(int), b._day.(int)}} {
+			if pair[0] < pair[1] {
+				return tenecs_compare_Less{}
+			} else if pair[0] > pair[1] {
+				return tenecs_compare_Greater{}
+			}
+		}
+		return tenecs_compare_Equal{}
+	},
+}`),
+	)
+}
 func tenecs_compare_eq() Function {
 	return function(
 		imports("reflect"),
@@ -7,3 +51,72 @@ func tenecs_compare_eq() Function {
 		body(`return reflect.DeepEqual(first, second)`),
 	)
 }
+func tenecs_compare_floatComparator() Function {
+	return function(
+		body(`return tenecs_compare_Comparator{
+	_compare: func(first any, second any) any {
+		if first.(float64) < second.(float64) {
+			return tenecs_compare_Less{}
+		} else if first.(float64) > second.(float64) {
+			return tenecs_compare_Greater{}
+		}
+		return tenecs_compare_Equal{}
+	},
+}`),
+	)
+}
+func tenecs_compare_intComparator() Function {
+	return function(
+		body(`return tenecs_compare_Comparator{
+	_compare: func(first any, second any) any {
+		if first.(int) < second.(int) {
+			return tenecs_compare_Less{}
+		} else if first.(int) > second.(int) {
+			return tenecs_compare_Greater{}
+		}
+		return tenecs_compare_Equal{}
+	},
+}`),
+	)
+}
+func tenecs_compare_reverse() Function {
+	return function(
+		params("comparator"),
+		body(`compare := comparator.(tenecs_compare_Comparator)._compare.(func(any, any) any)
+return tenecs_compare_Comparator{
+	_compare: func(first any, second any) any {
+		return compare(second, first)
+	},
+}`),
+	)
+}
+func tenecs_compare_stringComparator() Function {
+	return function(
+		body(`return tenecs_compare_Comparator{
+	_compare: func(first any, second any) any {
+		if first.(string) < second.(string) {
+			return tenecs_compare_Less{}
+		} else if first.(string) > second.(string) {
+			return tenecs_compare_Greater{}
+		}
+		return tenecs_compare_Equal{}
+	},
+}`),
+	)
+}
+func tenecs_compare_thenBy() Function {
+	return function(
+		params("first", "second"),
+		body(`compareFirst := first.(tenecs_compare_Comparator)._compare.(func(any, any) any)
+compareSecond := second.(tenecs_compare_Comparator)._compare.(func(any, any) any)
+return tenecs_compare_Comparator{
+	_compare: func(a any, b any) any {
+		ordering := compareFirst(a, b)
+		if _, ok := ordering.(tenecs_compare_Equal); ok {
+			return compareSecond(a, b)
+		}
+		return ordering
+	},
+}`),
+	)
+}
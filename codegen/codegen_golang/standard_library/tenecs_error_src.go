@@ -5,3 +5,90 @@ import "github.com/xplosunn/tenecs/typer/standard_library"
 func tenecs_error_Error() Function {
 	return structFunction(standard_library.Tenecs_error_Error)
 }
+func tenecs_error_ErrorDetail() Function {
+	return structFunction(standard_library.Tenecs_error_ErrorDetail)
+}
+func tenecs_error_causes() Function {
+	return function(
+		params("error"),
+		body(`result := []any{}
+var current any = error
+for current != nil {
+result = append(result, current)
+current = current.(tenecs_error_Error)._cause
+}
+return result`),
+	)
+}
+func tenecs_error_render() Function {
+	return function(
+		imports("strings"),
+		params("error"),
+		body(`lines := []string{}
+var current any = error
+for current != nil {
+e := current.(tenecs_error_Error)
+line := e._message.(string)
+if e._code != nil {
+line += " [" + e._code.(string) + "]"
+}
+if len(e._details.([]any)) > 0 {
+details := []string{}
+for _, d := range e._details.([]any) {
+detail := d.(tenecs_error_ErrorDetail)
+details = append(details, detail._key.(string)+"="+detail._value.(string))
+}
+line += " (" + strings.Join(details, ", ") + ")"
+}
+if len(lines) > 0 {
+line = "caused by: " + line
+}
+lines = append(lines, line)
+current = e._cause
+}
+return strings.Join(lines, "\n")`),
+	)
+}
+func tenecs_error_withCode() Function {
+	return function(
+		params("error", "code"),
+		body(`e := error.(tenecs_error_Error)
+e._code = code
+return e`),
+	)
+}
+func tenecs_error_withContext() Function {
+	return function(
+		params("result", "message"),
+		body(`if e, ok := result.(tenecs_error_Error); ok {
+return tenecs_error_Error{
+_message: message,
+_cause: e,
+_details: []any{},
+}
+}
+return result`),
+	)
+}
+func tenecs_error_withDetail() Function {
+	return function(
+		params("error", "key", "value"),
+		body(`e := error.(tenecs_error_Error)
+details := append([]any{}, e._details.([]any)...)
+e._details = append(details, tenecs_error_ErrorDetail{
+_key: key,
+_value: value,
+})
+return e`),
+	)
+}
+func tenecs_error_wrap() Function {
+	return function(
+		params("cause", "message"),
+		body(`return tenecs_error_Error{
+_message: message,
+_cause: cause,
+_details: []any{},
+}`),
+	)
+}
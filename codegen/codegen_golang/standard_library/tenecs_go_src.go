@@ -2,15 +2,39 @@ package standard_library
 
 import "github.com/xplosunn/tenecs/typer/standard_library"
 
+func tenecs_go_Concurrent() Function {
+	return structFunction(standard_library.Tenecs_go_Concurrent)
+}
 func tenecs_go_Console() Function {
 	return structFunction(standard_library.Tenecs_go_Console)
 }
+func tenecs_go_FileSystem() Function {
+	return structFunction(standard_library.Tenecs_go_FileSystem)
+}
+func tenecs_go_Http() Function {
+	return structFunction(standard_library.Tenecs_go_Http)
+}
+func tenecs_go_LogField() Function {
+	return structFunction(standard_library.Tenecs_go_LogField)
+}
+func tenecs_go_Logger() Function {
+	return structFunction(standard_library.Tenecs_go_Logger)
+}
 func tenecs_go_Main() Function {
 	return structFunction(standard_library.Tenecs_go_Main)
 }
+func tenecs_go_Process() Function {
+	return structFunction(standard_library.Tenecs_go_Process)
+}
+func tenecs_go_Random() Function {
+	return structFunction(standard_library.Tenecs_go_Random)
+}
 func tenecs_go_Runtime() Function {
 	return structFunction(standard_library.Tenecs_go_Runtime)
 }
+func tenecs_go_Task() Function {
+	return structFunction(standard_library.Tenecs_go_Task)
+}
 func tenecs_go_Time() Function {
 	return structFunction(standard_library.Tenecs_go_Time)
 }
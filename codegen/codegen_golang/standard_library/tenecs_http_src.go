@@ -0,0 +1,36 @@
+package standard_library
+
+import "github.com/xplosunn/tenecs/typer/standard_library"
+
+func tenecs_http_header() Function {
+	return function(
+		imports("strings"),
+		params("headers", "name"),
+		body(`for _, elem := range headers.([]any) {
+header := elem.(tenecs_http_Header)
+if strings.EqualFold(header._name.(string), name.(string)) {
+return header._value
+}
+}
+return nil`),
+	)
+}
+func tenecs_http_ok() Function {
+	return function(
+		params("body"),
+		body(`return tenecs_http_Response{
+  _status: 200,
+  _headers: []any{},
+  _body: body,
+}`),
+	)
+}
+func tenecs_http_Header() Function {
+	return structFunction(standard_library.Tenecs_http_Header)
+}
+func tenecs_http_Request() Function {
+	return structFunction(standard_library.Tenecs_http_Request)
+}
+func tenecs_http_Response() Function {
+	return structFunction(standard_library.Tenecs_http_Response)
+}
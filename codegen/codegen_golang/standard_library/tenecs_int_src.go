@@ -24,6 +24,7 @@ func tenecs_int_div() Function {
 		body(`if (b == 0) {
 return tenecs_error_Error{
 _message: "Division by zero",
+_details: []any{},
 }
 } else {
 return a.(int) / b.(int)
@@ -46,6 +47,7 @@ func tenecs_int_mod() Function {
 		body(`if (b == 0) {
 return tenecs_error_Error{
 _message: "Division by zero",
+_details: []any{},
 }
 } else {
 return a.(int) % b.(int)
@@ -90,3 +92,64 @@ func tenecs_int_negate() Function {
 		body(`return -a.(int)`),
 	)
 }
+
+const intOverflowHelper = `intOverflow := tenecs_error_Error{
+_message: "Int overflow",
+_details: []any{},
+}
+`
+
+func tenecs_int_checkedPlus() Function {
+	return function(
+		params("a", "b"),
+		body(intOverflowHelper+`result := a.(int) + b.(int)
+if (a.(int) >= 0) == (b.(int) >= 0) && (result >= 0) != (a.(int) >= 0) {
+return intOverflow
+}
+return result`),
+	)
+}
+func tenecs_int_checkedMinus() Function {
+	return function(
+		params("a", "b"),
+		body(intOverflowHelper+`result := a.(int) - b.(int)
+if (a.(int) >= 0) != (b.(int) >= 0) && (result >= 0) != (a.(int) >= 0) {
+return intOverflow
+}
+return result`),
+	)
+}
+func tenecs_int_checkedTimes() Function {
+	return function(
+		imports("math"),
+		params("a", "b"),
+		body(intOverflowHelper+`result := a.(int) * b.(int)
+if a.(int) != 0 && (result/a.(int) != b.(int) || (a.(int) == -1 && b.(int) == math.MinInt)) {
+return intOverflow
+}
+return result`),
+	)
+}
+func tenecs_int_checkedNegate() Function {
+	return function(
+		imports("math"),
+		params("a"),
+		body(intOverflowHelper+`if a.(int) == math.MinInt {
+return intOverflow
+}
+return -a.(int)`),
+	)
+}
+func tenecs_int_checkedAbs() Function {
+	return function(
+		imports("math"),
+		params("a"),
+		body(intOverflowHelper+`if a.(int) == math.MinInt {
+return intOverflow
+}
+if a.(int) < 0 {
+return -a.(int)
+}
+return a.(int)`),
+	)
+}
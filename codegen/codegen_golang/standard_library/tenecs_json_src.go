@@ -6,20 +6,474 @@ import (
 	"strings"
 )
 
+const jsonFailureHelper = `jsonFailure := func(decodeErrors []tenecs_json_JsonDecodeError) any {
+	lines := []string{}
+	details := []any{}
+	for _, decodeError := range decodeErrors {
+		lines = append(lines, decodeError._path.(string)+": "+decodeError._message.(string))
+		details = append(details,
+			tenecs_error_ErrorDetail{_key: "json.path", _value: decodeError._path},
+			tenecs_error_ErrorDetail{_key: "json.expected", _value: decodeError._expected},
+			tenecs_error_ErrorDetail{_key: "json.actual", _value: decodeError._actual},
+			tenecs_error_ErrorDetail{_key: "json.message", _value: decodeError._message},
+		)
+	}
+	return tenecs_error_Error{
+		_message: strings.Join(lines, "\n"),
+		_details: details,
+	}
+}
+`
+
+const jsonExpectedHelper = jsonFailureHelper + `jsonKind := func(input any) string {
+	if input == nil {
+		return "missing"
+	}
+	var parsed any
+	if json.Unmarshal([]byte(input.(string)), &parsed) != nil {
+		return "invalid json"
+	}
+	switch parsed.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	}
+	return "object"
+}
+jsonExpected := func(expected string, input any) any {
+	actual := jsonKind(input)
+	return jsonFailure([]tenecs_json_JsonDecodeError{{
+		_path: "$",
+		_expected: expected,
+		_actual: actual,
+		_message: "expected " + expected + ", got " + actual,
+	}})
+}
+`
+
+const jsonDecodeErrorsHelper = `jsonDecodeErrors := func(err tenecs_error_Error) []tenecs_json_JsonDecodeError {
+	decodeErrors := []tenecs_json_JsonDecodeError{}
+	for _, detail := range err._details.([]any) {
+		key := detail.(tenecs_error_ErrorDetail)._key.(string)
+		value := detail.(tenecs_error_ErrorDetail)._value
+		if key == "json.path" {
+			decodeErrors = append(decodeErrors, tenecs_json_JsonDecodeError{_path: value, _expected: "", _actual: "", _message: ""})
+			continue
+		}
+		if len(decodeErrors) == 0 {
+			continue
+		}
+		last := &decodeErrors[len(decodeErrors)-1]
+		switch key {
+		case "json.expected":
+			last._expected = value
+		case "json.actual":
+			last._actual = value
+		case "json.message":
+			last._message = value
+		}
+	}
+	if len(decodeErrors) == 0 {
+		decodeErrors = append(decodeErrors, tenecs_json_JsonDecodeError{_path: "$", _expected: "", _actual: "", _message: err._message})
+	}
+	return decodeErrors
+}
+`
+
+const jsonOrHelper = `jsonOrFailure := func(errs []tenecs_error_Error) any {
+	expected := []string{}
+	actual := ""
+	for _, err := range errs {
+		decodeErrors := jsonDecodeErrors(err)
+		if len(decodeErrors) != 1 || decodeErrors[0]._path.(string) != "$" || decodeErrors[0]._expected.(string) == "" {
+			return err
+		}
+		expected = append(expected, decodeErrors[0]._expected.(string))
+		actual = decodeErrors[0]._actual.(string)
+	}
+	return jsonFailure([]tenecs_json_JsonDecodeError{{
+		_path: "$",
+		_expected: strings.Join(expected, " | "),
+		_actual: actual,
+		_message: "expected " + strings.Join(expected, " | ") + ", got " + actual,
+	}})
+}
+`
+
+const jsonAtHelper = `jsonAt := func(segment string, err tenecs_error_Error) []tenecs_json_JsonDecodeError {
+	decodeErrors := jsonDecodeErrors(err)
+	for i, decodeError := range decodeErrors {
+		decodeErrors[i]._path = "$" + segment + strings.TrimPrefix(decodeError._path.(string), "$")
+	}
+	return decodeErrors
+}
+`
+
+const jsonIntHelper = `// jsonInt reads an integer from its digits, so that it's exact over the whole Int range,
+// and otherwise takes a number that's an integer within the safe range of a float
+jsonInt := func(input any) (int, bool) {
+	jsonString, _ := input.(string)
+	trimmed := strings.TrimSpace(jsonString)
+	if json.Valid([]byte(trimmed)) {
+		if result, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+			return int(result), true
+		}
+	}
+	var output any
+	json.Unmarshal([]byte(jsonString), &output)
+	result, ok := output.(float64)
+	if !ok || float64(int(result)) != result || math.Abs(result) > 1<<53-1 {
+		return 0, false
+	}
+	return int(result), true
+}
+`
+
+const jsonKeyHelper = `jsonKey := func(key string) string {
+	isIdentifier := key != ""
+	for i, c := range key {
+		isLetter := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		isDigit := c >= '0' && c <= '9'
+		if !isLetter && !(i > 0 && isDigit) {
+			isIdentifier = false
+		}
+	}
+	if isIdentifier {
+		return "." + key
+	}
+	buffer := bytes.Buffer{}
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(key)
+	return "[" + strings.TrimSuffix(buffer.String(), "\n") + "]"
+}
+`
+
+func tenecs_json_decodeErrors() Function {
+	return function(
+		params("error"),
+		body(jsonDecodeErrorsHelper+`result := []any{}
+for _, decodeError := range jsonDecodeErrors(error.(tenecs_error_Error)) {
+	result = append(result, decodeError)
+}
+return result`),
+	)
+}
+
 func tenecs_json_jsonBoolean() Function {
 	return function(
-		imports("encoding/json"),
-		body(`return tenecs_json_JsonConverter{
+		imports("encoding/json", "strings"),
+		body(jsonExpectedHelper+`return tenecs_json_JsonConverter{
 	_fromJson: func(input any) any {
-		jsonString := input.(string)
-		var output bool
-		err := json.Unmarshal([]byte(jsonString), &output)
-		if err != nil {
-			return tenecs_error_Error{
-				_message: "Could not parse Boolean from " + jsonString,
-			} 
+		jsonString, _ := input.(string)
+		var output any
+		json.Unmarshal([]byte(jsonString), &output)
+		result, ok := output.(bool)
+		if !ok {
+			return jsonExpected("Boolean", input)
+		}
+		return result
+	},
+	_toJson: func(input any) any {
+		result, _ := json.Marshal(input)
+		return string(result)
+	},
+}`),
+	)
+}
+
+func tenecs_json_jsonConverter() Function {
+	return function(
+		imports("bytes", "encoding/json", "math", "sort", "strconv", "strings"),
+		params("typeName"),
+		body(jsonExpectedHelper+jsonDecodeErrorsHelper+jsonAtHelper+jsonKeyHelper+jsonIntHelper+`compact := func(raw json.RawMessage) string {
+	buffer := bytes.Buffer{}
+	json.Compact(&buffer, raw)
+	return buffer.String()
+}
+jsonKindOf := map[string]string{
+	"String":  "string",
+	"Int":     "number",
+	"Float":   "number",
+	"Boolean": "boolean",
+	"Void":    "null",
+	"List":    "array",
+	"Struct":  "object",
+}
+orTags := func(derived tenecsJsonDerivedType) []string {
+	tags := []string{}
+	for _, option := range derived.of {
+		if optionDerived := tenecsJsonDerivedTypes[option]; optionDerived.kind == "Struct" {
+			tags = append(tags, optionDerived.name)
+		}
+	}
+	return tags
+}
+var fromJson func(typeName string, input any) any
+var matches func(typeName string, value any) bool
+var toJson func(typeName string, value any) string
+fromJson = func(typeName string, input any) any {
+	derived := tenecsJsonDerivedTypes[typeName]
+	jsonString, _ := input.(string)
+	var parsed any
+	json.Unmarshal([]byte(jsonString), &parsed)
+	switch derived.kind {
+	case "String":
+		output, ok := parsed.(string)
+		if !ok {
+			return jsonExpected("String", input)
+		}
+		return output
+	case "Int":
+		output, ok := jsonInt(input)
+		if !ok {
+			return jsonExpected("Int", input)
 		}
 		return output
+	case "Float":
+		output, ok := parsed.(float64)
+		if !ok {
+			return jsonExpected("Float", input)
+		}
+		return output
+	case "Boolean":
+		output, ok := parsed.(bool)
+		if !ok {
+			return jsonExpected("Boolean", input)
+		}
+		return output
+	case "Void":
+		if input != nil && strings.TrimSpace(jsonString) != "null" {
+			return jsonExpected("Void", input)
+		}
+		return nil
+	case "List":
+		var output []json.RawMessage
+		if json.Unmarshal([]byte(jsonString), &output) != nil || output == nil {
+			return jsonExpected("List", input)
+		}
+		outputList := []any{}
+		failures := []tenecs_json_JsonDecodeError{}
+		for i, elem := range output {
+			result := fromJson(derived.of[0], compact(elem))
+			if err, isError := result.(tenecs_error_Error); isError {
+				failures = append(failures, jsonAt("["+strconv.Itoa(i)+"]", err)...)
+				continue
+			}
+			outputList = append(outputList, result)
+		}
+		if len(failures) > 0 {
+			return jsonFailure(failures)
+		}
+		return outputList
+	case "Or":
+		kind := jsonKind(input)
+		if kind == "missing" {
+			kind = "null"
+		}
+		tags := orTags(derived)
+		expected := []string{}
+		for _, option := range derived.of {
+			optionDerived := tenecsJsonDerivedTypes[option]
+			if optionDerived.kind == "Struct" {
+				expected = append(expected, optionDerived.name)
+			} else {
+				expected = append(expected, optionDerived.kind)
+			}
+			if jsonKindOf[optionDerived.kind] != kind || (kind == "object" && len(tags) > 1) {
+				continue
+			}
+			return fromJson(option, input)
+		}
+		if kind != "object" || len(tags) < 2 {
+			return jsonExpected(strings.Join(expected, " | "), input)
+		}
+		var output map[string]any
+		json.Unmarshal([]byte(jsonString), &output)
+		tag, isTagged := output["$type"]
+		for _, option := range derived.of {
+			if optionDerived := tenecsJsonDerivedTypes[option]; optionDerived.kind == "Struct" && optionDerived.name == tag {
+				return fromJson(option, input)
+			}
+		}
+		var tagInput any
+		if isTagged {
+			tagJson, _ := json.Marshal(tag)
+			tagInput = string(tagJson)
+		}
+		return jsonFailure(jsonAt(jsonKey("$type"), jsonExpected(strings.Join(tags, " | "), tagInput).(tenecs_error_Error)))
+	case "Struct":
+		var output map[string]json.RawMessage
+		if json.Unmarshal([]byte(jsonString), &output) != nil || output == nil {
+			return jsonExpected("object", input)
+		}
+		values := map[string]any{}
+		failures := []tenecs_json_JsonDecodeError{}
+		for i, field := range derived.fields {
+			var fieldInput any
+			if fieldJson, ok := output[field]; ok {
+				fieldInput = compact(fieldJson)
+			}
+			result := fromJson(derived.of[i], fieldInput)
+			if err, isError := result.(tenecs_error_Error); isError {
+				failures = append(failures, jsonAt(jsonKey(field), err)...)
+				continue
+			}
+			values[field] = result
+		}
+		if len(failures) > 0 {
+			return jsonFailure(failures)
+		}
+		return derived.build(values)
+	}
+	panic("unexpected derived json kind " + derived.kind)
+}
+matches = func(typeName string, value any) bool {
+	derived := tenecsJsonDerivedTypes[typeName]
+	switch derived.kind {
+	case "String":
+		_, ok := value.(string)
+		return ok
+	case "Int":
+		_, ok := value.(int)
+		return ok
+	case "Float":
+		_, ok := value.(float64)
+		return ok
+	case "Boolean":
+		_, ok := value.(bool)
+		return ok
+	case "Void":
+		return value == nil
+	case "List":
+		list, ok := value.([]any)
+		if !ok {
+			return false
+		}
+		for _, elem := range list {
+			if !matches(derived.of[0], elem) {
+				return false
+			}
+		}
+		return true
+	case "Or":
+		for _, option := range derived.of {
+			if matches(option, value) {
+				return true
+			}
+		}
+		return false
+	case "Struct":
+		if !derived.is(value) {
+			return false
+		}
+		values := derived.get(value)
+		for i, field := range derived.fields {
+			if !matches(derived.of[i], values[field]) {
+				return false
+			}
+		}
+		return true
+	}
+	panic("unexpected derived json kind " + derived.kind)
+}
+toJson = func(typeName string, value any) string {
+	derived := tenecsJsonDerivedTypes[typeName]
+	switch derived.kind {
+	case "String", "Int", "Float", "Boolean":
+		result, _ := json.Marshal(value)
+		return string(result)
+	case "Void":
+		return "null"
+	case "List":
+		results := []string{}
+		for _, elem := range value.([]any) {
+			results = append(results, toJson(derived.of[0], elem))
+		}
+		return "[" + strings.Join(results, ",") + "]"
+	case "Or":
+		for _, option := range derived.of {
+			if !matches(option, value) {
+				continue
+			}
+			result := toJson(option, value)
+			optionDerived := tenecsJsonDerivedTypes[option]
+			if optionDerived.kind != "Struct" || len(orTags(derived)) < 2 {
+				return result
+			}
+			tagJson, _ := json.Marshal(optionDerived.name)
+			fieldsJson := strings.TrimSuffix(strings.TrimPrefix(result, "{"), "}")
+			if fieldsJson != "" {
+				fieldsJson = "," + fieldsJson
+			}
+			return "{\"$type\":" + string(tagJson) + fieldsJson + "}"
+		}
+	case "Struct":
+		values := derived.get(value)
+		fieldIndexes := map[string]int{}
+		for i, field := range derived.fields {
+			fieldIndexes[field] = i
+		}
+		fields := append([]string{}, derived.fields...)
+		sort.Strings(fields)
+		results := []string{}
+		for _, field := range fields {
+			nameBytes, _ := json.Marshal(field)
+			results = append(results, string(nameBytes)+":"+toJson(derived.of[fieldIndexes[field]], values[field]))
+		}
+		return "{" + strings.Join(results, ",") + "}"
+	}
+	panic("can't convert to json as " + typeName)
+}
+name := typeName.(string)
+return tenecs_json_JsonConverter{
+	_fromJson: func(input any) any {
+		return fromJson(name, input)
+	},
+	_toJson: func(input any) any {
+		return toJson(name, input)
+	},
+}`),
+	)
+}
+
+func tenecs_json_jsonFirstError() Function {
+	return function(
+		imports("strings"),
+		params("of"),
+		body(jsonFailureHelper+jsonDecodeErrorsHelper+`return tenecs_json_JsonConverter{
+	_fromJson: func(input any) any {
+		result := of.(tenecs_json_JsonConverter)._fromJson.(func(any)any)(input)
+		if err, isError := result.(tenecs_error_Error); isError {
+			return jsonFailure(jsonDecodeErrors(err)[:1])
+		}
+		return result
+	},
+	_toJson: of.(tenecs_json_JsonConverter)._toJson,
+}`),
+	)
+}
+
+func tenecs_json_jsonFloat() Function {
+	return function(
+		imports("encoding/json", "strings"),
+		body(jsonExpectedHelper+`return tenecs_json_JsonConverter{
+	_fromJson: func(input any) any {
+		jsonString, _ := input.(string)
+		var output any
+		json.Unmarshal([]byte(jsonString), &output)
+		result, ok := output.(float64)
+		if !ok {
+			return jsonExpected("Float", input)
+		}
+		return result
 	},
 	_toJson: func(input any) any {
 		result, _ := json.Marshal(input)
@@ -31,18 +485,14 @@ func tenecs_json_jsonBoolean() Function {
 
 func tenecs_json_jsonInt() Function {
 	return function(
-		imports("encoding/json"),
-		body(`return tenecs_json_JsonConverter{
+		imports("encoding/json", "math", "strconv", "strings"),
+		body(jsonExpectedHelper+jsonIntHelper+`return tenecs_json_JsonConverter{
 	_fromJson: func(input any) any {
-		jsonString := input.(string)
-		var output float64
-		err := json.Unmarshal([]byte(jsonString), &output)
-		if err != nil || float64(int(output)) != output {
-			return tenecs_error_Error{
-				_message: "Could not parse Int from " + jsonString,
-			} 
+		result, ok := jsonInt(input)
+		if !ok {
+			return jsonExpected("Int", input)
 		}
-		return int(output)
+		return result
 	},
 	_toJson: func(input any) any {
 		result, _ := json.Marshal(input)
@@ -52,26 +502,67 @@ func tenecs_json_jsonInt() Function {
 	)
 }
 
+func tenecs_json_jsonNullable() Function {
+	return function(
+		imports("encoding/json", "strings"),
+		params("of"),
+		body(jsonExpectedHelper+jsonDecodeErrorsHelper+jsonOrHelper+`return tenecs_json_JsonConverter{
+	_fromJson: func(input any) any {
+		jsonString, _ := input.(string)
+		if strings.TrimSpace(jsonString) == "null" {
+			return nil
+		}
+		result := of.(tenecs_json_JsonConverter)._fromJson.(func(any)any)(input)
+		if err, isError := result.(tenecs_error_Error); isError {
+			return jsonOrFailure([]tenecs_error_Error{
+				err,
+				jsonExpected("Void", input).(tenecs_error_Error),
+			})
+		}
+		return result
+	},
+	_toJson: func(input any) any {
+		if input == nil {
+			return "null"
+		}
+		return of.(tenecs_json_JsonConverter)._toJson.(func(any)any)(input)
+	},
+}`),
+	)
+}
+
+func tenecs_json_jsonOptional() Function {
+	return function(
+		params("of", "whenMissing"),
+		body(`return tenecs_json_JsonConverter{
+	_fromJson: func(input any) any {
+		if input == nil {
+			return whenMissing
+		}
+		return of.(tenecs_json_JsonConverter)._fromJson.(func(any)any)(input)
+	},
+	_toJson: of.(tenecs_json_JsonConverter)._toJson,
+}`),
+	)
+}
+
 func tenecs_json_jsonOr() Function {
 	return function(
-		imports("encoding/json"),
+		imports("strings"),
 		params("ConverterA", "ConverterB", "toJsonConverterPicker"),
-		body(`return tenecs_json_JsonConverter{
+		body(jsonFailureHelper+jsonDecodeErrorsHelper+jsonOrHelper+`return tenecs_json_JsonConverter{
 	_fromJson: func(input any) any {
 		resultA := ConverterA.(tenecs_json_JsonConverter)._fromJson.(func(any)any)(input)
-		_, isMap := resultA.(tenecs_error_Error)
-		if isMap {
-			resultB := ConverterB.(tenecs_json_JsonConverter)._fromJson.(func(any)any)(input)
-			_, isMap := resultB.(tenecs_error_Error)
-			if isMap {
-				jsonString := input.(string)
-				return tenecs_error_Error{
-					_message: "Could not parse from " + jsonString,
-				}
-			}
+		errA, isErrorA := resultA.(tenecs_error_Error)
+		if !isErrorA {
+			return resultA
+		}
+		resultB := ConverterB.(tenecs_json_JsonConverter)._fromJson.(func(any)any)(input)
+		errB, isErrorB := resultB.(tenecs_error_Error)
+		if !isErrorB {
 			return resultB
 		}
-		return resultA
+		return jsonOrFailure([]tenecs_error_Error{errA, errB})
 	},
 	_toJson: func(input any) any {
 		Converter := toJsonConverterPicker.(func(any)any)(input)
@@ -83,18 +574,17 @@ func tenecs_json_jsonOr() Function {
 
 func tenecs_json_jsonString() Function {
 	return function(
-		imports("encoding/json"),
-		body(`return tenecs_json_JsonConverter{
+		imports("encoding/json", "strings"),
+		body(jsonExpectedHelper+`return tenecs_json_JsonConverter{
 	_fromJson: func(input any) any {
-		jsonString := input.(string)
-		var output string
-		err := json.Unmarshal([]byte(jsonString), &output)
-		if err != nil {
-			return tenecs_error_Error{
-				_message: "Could not parse String from " + jsonString,
-			}
+		jsonString, _ := input.(string)
+		var output any
+		json.Unmarshal([]byte(jsonString), &output)
+		result, ok := output.(string)
+		if !ok {
+			return jsonExpected("String", input)
 		}
-		return output
+		return result
 	},
 	_toJson: func(input any) any {
 		result, _ := json.Marshal(input)
@@ -104,34 +594,105 @@ func tenecs_json_jsonString() Function {
 	)
 }
 
+func tenecs_json_jsonValue() Function {
+	return function(
+		imports("encoding/json", "sort", "strings"),
+		body(jsonExpectedHelper+`var fromAny func(value any) any
+fromAny = func(value any) any {
+	switch v := value.(type) {
+	case []any:
+		values := []any{}
+		for _, elem := range v {
+			values = append(values, fromAny(elem))
+		}
+		return tenecs_json_JsonArray{
+			_values: values,
+		}
+	case map[string]any:
+		keys := []string{}
+		for key, _ := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		entries := []any{}
+		for _, key := range keys {
+			entries = append(entries, tenecs_json_JsonEntry{
+				_key:   key,
+				_value: fromAny(v[key]),
+			})
+		}
+		return tenecs_json_JsonObject{
+			_entries: entries,
+		}
+	}
+	return value
+}
+var toJson func(value any) string
+toJson = func(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case tenecs_json_JsonArray:
+		results := []string{}
+		for _, elem := range v._values.([]any) {
+			results = append(results, toJson(elem))
+		}
+		return "[" + strings.Join(results, ",") + "]"
+	case tenecs_json_JsonObject:
+		results := []string{}
+		for _, entry := range v._entries.([]any) {
+			nameBytes, _ := json.Marshal(entry.(tenecs_json_JsonEntry)._key)
+			results = append(results, string(nameBytes)+":"+toJson(entry.(tenecs_json_JsonEntry)._value))
+		}
+		return "{" + strings.Join(results, ",") + "}"
+	}
+	result, _ := json.Marshal(value)
+	return string(result)
+}
+return tenecs_json_JsonConverter{
+	_fromJson: func(input any) any {
+		jsonString, _ := input.(string)
+		var output any
+		err := json.Unmarshal([]byte(jsonString), &output)
+		if err != nil {
+			return jsonExpected("JsonValue", input)
+		}
+		return fromAny(output)
+	},
+	_toJson: func(input any) any {
+		return toJson(input)
+	},
+}`),
+	)
+}
+
 func tenecs_json_jsonList() Function {
 	return function(
-		imports("encoding/json", "strings"),
+		imports("encoding/json", "strconv", "strings"),
 		params("of"),
-		body(`return tenecs_json_JsonConverter{
+		body(jsonExpectedHelper+jsonDecodeErrorsHelper+jsonAtHelper+`return tenecs_json_JsonConverter{
 	_fromJson: func(input any) any {
-		jsonString := input.(string)
+		jsonString, _ := input.(string)
 		var output []json.RawMessage
 		err := json.Unmarshal([]byte(jsonString), &output)
-		if err != nil {
-			return tenecs_error_Error{
-				_message: "Could not parse List from " + jsonString,
-			} 
-		}
-		if len(output) == 0 {
-			return []any{}
+		if err != nil || output == nil {
+			return jsonExpected("List", input)
 		}
 		ofParse := of.(tenecs_json_JsonConverter)._fromJson.(func(any)any)
 		outputList := []any{}
-		for _, elem := range output {
+		failures := []tenecs_json_JsonDecodeError{}
+		for i, elem := range output {
 			elemJsonBytes, _ := json.Marshal(&elem)
 			result := ofParse(string(elemJsonBytes))
-			resultMap, isMap := result.(tenecs_error_Error)
-			if isMap {
-				return resultMap
+			if err, isError := result.(tenecs_error_Error); isError {
+				failures = append(failures, jsonAt("[" + strconv.Itoa(i) + "]", err)...)
+				continue
 			}
 			outputList = append(outputList, result)
 		}
+		if len(failures) > 0 {
+			return jsonFailure(failures)
+		}
 		return outputList
 	},
 	_toJson: func(input any) any {
@@ -149,17 +710,15 @@ func tenecs_json_jsonList() Function {
 
 func tenecs_json_jsonObject0() Function {
 	return function(
-		imports("encoding/json"),
+		imports("encoding/json", "strings"),
 		params("build"),
-		body(`return tenecs_json_JsonConverter{
+		body(jsonExpectedHelper+`return tenecs_json_JsonConverter{
 	_fromJson: func(input any) any {
-		jsonString := input.(string)
+		jsonString, _ := input.(string)
 		var output map[string]json.RawMessage
 		err := json.Unmarshal([]byte(jsonString), &output)
-		if err != nil {
-			return tenecs_error_Error{
-				_message: "Could not parse object from " + jsonString,
-			} 
+		if err != nil || output == nil {
+			return jsonExpected("object", input)
 		}
 
 		return build.(func()any)()
@@ -176,35 +735,34 @@ func tenecs_json_jsonObject_X(x int) Function {
 	for i := 0; i < x; i++ {
 		paramNames = append(paramNames, fmt.Sprintf("jsonConverterFieldI%d", i))
 	}
-	bodyStr := `return tenecs_json_JsonConverter{
+	bodyStr := jsonExpectedHelper + jsonDecodeErrorsHelper + jsonAtHelper + jsonKeyHelper + `return tenecs_json_JsonConverter{
 	_fromJson: func(input any) any {
-		jsonString := input.(string)
+		jsonString, _ := input.(string)
 		var output map[string]json.RawMessage
 		err := json.Unmarshal([]byte(jsonString), &output)
-		if err != nil {
-			return tenecs_error_Error{
-				_message: "Could not parse object from " + jsonString,
-			} 
+		if err != nil || output == nil {
+			return jsonExpected("object", input)
 		}
+		failures := []tenecs_json_JsonDecodeError{}
 `
 	for i := 0; i < x; i++ {
-		bodyStr += fmt.Sprintf(`
-		i%dName := jsonConverterFieldI%d.(tenecs_json_JsonField)._name.(string)
-		i%dJsonRawMessage := output[i%dName]
-		if i%dJsonRawMessage == nil {
-			return tenecs_error_Error{
-				_message: "Could not find object field \"" + i%dName + "\" in " + jsonString,
-			}
+		bodyStr += strings.ReplaceAll(`
+		iXName := jsonConverterFieldIX.(tenecs_json_JsonField)._name.(string)
+		iXJsonRawMessage := output[iXName]
+		var iXJsonString any
+		if iXJsonRawMessage != nil {
+			iXJsonBytes, _ := json.Marshal(&iXJsonRawMessage)
+			iXJsonString = string(iXJsonBytes)
 		}
-		i%dJsonBytes, _ := json.Marshal(&i%dJsonRawMessage)
-		i%d := jsonConverterFieldI%d.(tenecs_json_JsonField)._Converter.(tenecs_json_JsonConverter)._fromJson.(func(any)any)(string(i%dJsonBytes))
-		i%dMap, isMap := i%d.(tenecs_error_Error)
-		if isMap {
-			return tenecs_error_Error{
-				_message: "Could not parse object field \"" + i%dName + "\": " + i%dMap._message.(string),
-			} 
-		}`, i, i, i, i, i, i, i, i, i, i, i, i, i, i, i)
+		iX := jsonConverterFieldIX.(tenecs_json_JsonField)._Converter.(tenecs_json_JsonConverter)._fromJson.(func(any)any)(iXJsonString)
+		if err, isError := iX.(tenecs_error_Error); isError {
+			failures = append(failures, jsonAt(jsonKey(iXName), err)...)
+		}`, "X", fmt.Sprint(i))
 	}
+	bodyStr += `
+		if len(failures) > 0 {
+			return jsonFailure(failures)
+		}`
 	anys := []string{}
 	buildArgs := []string{}
 	for i := 0; i < x; i++ {
@@ -254,7 +812,7 @@ func tenecs_json_jsonObject_X(x int) Function {
 }`
 
 	return function(
-		imports("encoding/json", "sort"),
+		imports("bytes", "encoding/json", "sort", "strings"),
 		params(paramNames...),
 		body(bodyStr),
 	)
@@ -326,9 +884,32 @@ func tenecs_json_jsonObject16() Function {
 func tenecs_json_jsonObject20() Function {
 	return tenecs_json_jsonObject_X(20)
 }
+func tenecs_json_JsonArray() Function {
+	return structFunction(standard_library.Tenecs_json_JsonArray)
+}
+func tenecs_json_JsonEntry() Function {
+	return structFunction(standard_library.Tenecs_json_JsonEntry)
+}
+func tenecs_json_JsonDecodeError() Function {
+	return structFunction(standard_library.Tenecs_json_JsonDecodeError)
+}
 func tenecs_json_JsonField() Function {
 	return structFunction(standard_library.Tenecs_json_JsonField)
 }
+func tenecs_json_JsonObject() Function {
+	return structFunction(standard_library.Tenecs_json_JsonObject)
+}
 func tenecs_json_JsonConverter() Function {
-	return structFunction(standard_library.Tenecs_json_JsonConverter)
+	return structFunctionConstructedBy(standard_library.Tenecs_json_JsonConverter, function(
+		params("fromJson", "toJson"),
+		body(`return tenecs_json_JsonConverter{
+	_fromJson: func(input any) any {
+		if input == nil {
+			return fromJson.(func(any)any)("")
+		}
+		return fromJson.(func(any)any)(input)
+	},
+	_toJson: toJson,
+}`),
+	))
 }
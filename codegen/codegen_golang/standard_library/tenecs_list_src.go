@@ -150,6 +150,7 @@ return l[idx]
 }
 return tenecs_error_Error{
 _message: "Out of bounds",
+_details: []any{},
 }
 `),
 	)
@@ -167,6 +168,7 @@ return result
 }
 return tenecs_error_Error{
 _message: "Out of bounds",
+_details: []any{},
 }
 `),
 	)
@@ -188,3 +190,253 @@ return result
 `),
 	)
 }
+func tenecs_list_max() Function {
+	return function(
+		params("list", "comparator"),
+		body(`compare := comparator.(tenecs_compare_Comparator)._compare.(func(any, any) any)
+var result any
+for i, elem := range list.([]any) {
+if i == 0 {
+result = elem
+} else if _, less := compare(elem, result).(tenecs_compare_Less); !less {
+result = elem
+}
+}
+return result`),
+	)
+}
+func tenecs_list_min() Function {
+	return function(
+		params("list", "comparator"),
+		body(`compare := comparator.(tenecs_compare_Comparator)._compare.(func(any, any) any)
+var result any
+for i, elem := range list.([]any) {
+if i == 0 {
+result = elem
+} else if _, less := compare(elem, result).(tenecs_compare_Less); less {
+result = elem
+}
+}
+return result`),
+	)
+}
+func tenecs_list_sortBy() Function {
+	return function(
+		imports("sort"),
+		params("list", "comparator"),
+		body(`compare := comparator.(tenecs_compare_Comparator)._compare.(func(any, any) any)
+result := append([]any{}, list.([]any)...)
+sort.SliceStable(result, func(i, j int) bool {
+_, less := compare(result[i], result[j]).(tenecs_compare_Less)
+return less
+})
+return result`),
+	)
+}
+func tenecs_list_all() Function {
+	return function(
+		params("list", "predicate"),
+		body(`for _, elem := range list.([]any) {
+if !predicate.(func(any)any)(elem).(bool) {
+return false
+}
+}
+return true`),
+	)
+}
+func tenecs_list_any() Function {
+	return function(
+		params("list", "predicate"),
+		body(`for _, elem := range list.([]any) {
+if predicate.(func(any)any)(elem).(bool) {
+return true
+}
+}
+return false`),
+	)
+}
+func tenecs_list_chunked() Function {
+	return function(
+		params("list", "size"),
+		body(`l := list.([]any)
+n := size.(int)
+if n < 1 {
+return tenecs_error_Error{
+_message: "Chunk size must be positive",
+_details: []any{},
+}
+}
+result := []any{}
+for start := 0; start < len(l); start += n {
+end := start + n
+if end > len(l) {
+end = len(l)
+}
+result = append(result, append([]any{}, l[start:end]...))
+}
+return result`),
+	)
+}
+func tenecs_list_distinct() Function {
+	return function(
+		imports("reflect"),
+		params("list"),
+		body(`result := []any{}
+for _, elem := range list.([]any) {
+seen := false
+for _, kept := range result {
+if reflect.DeepEqual(elem, kept) {
+seen = true
+break
+}
+}
+if !seen {
+result = append(result, elem)
+}
+}
+return result`),
+	)
+}
+func tenecs_list_drop() Function {
+	return function(
+		params("list", "count"),
+		body(`l := list.([]any)
+n := count.(int)
+if n < 0 {
+n = 0
+}
+if n > len(l) {
+n = len(l)
+}
+return append([]any{}, l[n:]...)`),
+	)
+}
+func tenecs_list_Group() Function {
+	return structFunction(standard_library.Tenecs_list_Group)
+}
+func tenecs_list_groupBy() Function {
+	return function(
+		imports("reflect"),
+		params("list", "key"),
+		body(`result := []any{}
+for _, elem := range list.([]any) {
+k := key.(func(any)any)(elem)
+found := false
+for i, g := range result {
+group := g.(tenecs_list_Group)
+if reflect.DeepEqual(group._key, k) {
+group._values = append(group._values.([]any), elem)
+result[i] = group
+found = true
+break
+}
+}
+if !found {
+result = append(result, tenecs_list_Group{
+_key: k,
+_values: []any{elem},
+})
+}
+}
+return result`),
+	)
+}
+func tenecs_list_Indexed() Function {
+	return structFunction(standard_library.Tenecs_list_Indexed)
+}
+func tenecs_list_indexed() Function {
+	return function(
+		params("list"),
+		body(`result := []any{}
+for i, elem := range list.([]any) {
+result = append(result, tenecs_list_Indexed{
+_index: i,
+_value: elem,
+})
+}
+return result`),
+	)
+}
+func tenecs_list_last() Function {
+	return function(
+		params("list"),
+		body(`l := list.([]any)
+if len(l) == 0 {
+return nil
+}
+return l[len(l)-1]`),
+	)
+}
+func tenecs_list_Pair() Function {
+	return structFunction(standard_library.Tenecs_list_Pair)
+}
+func tenecs_list_Partition() Function {
+	return structFunction(standard_library.Tenecs_list_Partition)
+}
+func tenecs_list_partition() Function {
+	return function(
+		params("list", "predicate"),
+		body(`matching := []any{}
+notMatching := []any{}
+for _, elem := range list.([]any) {
+if predicate.(func(any)any)(elem).(bool) {
+matching = append(matching, elem)
+} else {
+notMatching = append(notMatching, elem)
+}
+}
+return tenecs_list_Partition{
+_matching: matching,
+_notMatching: notMatching,
+}`),
+	)
+}
+func tenecs_list_range() Function {
+	return function(
+		params("from", "until"),
+		body(`result := []any{}
+for i := from.(int); i < until.(int); i++ {
+result = append(result, i)
+}
+return result`),
+	)
+}
+func tenecs_list_sum() Function {
+	return function(
+		params("list"),
+		body(`result := 0
+for _, elem := range list.([]any) {
+result += elem.(int)
+}
+return result`),
+	)
+}
+func tenecs_list_take() Function {
+	return function(
+		params("list", "count"),
+		body(`l := list.([]any)
+n := count.(int)
+if n < 0 {
+n = 0
+}
+if n > len(l) {
+n = len(l)
+}
+return append([]any{}, l[:n]...)`),
+	)
+}
+func tenecs_list_zip() Function {
+	return function(
+		params("left", "right"),
+		body(`l := left.([]any)
+r := right.([]any)
+result := []any{}
+for i := 0; i < len(l) && i < len(r); i++ {
+result = append(result, tenecs_list_Pair{
+_left: l[i],
+_right: r[i],
+})
+}
+return result`),
+	)
+}
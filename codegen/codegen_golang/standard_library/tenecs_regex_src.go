@@ -0,0 +1,165 @@
+package standard_library
+
+import "github.com/xplosunn/tenecs/typer/standard_library"
+
+const regexUnsupportedSyntaxHelper = `regexUnsupportedSyntax := func(pattern string) string {
+	runes := []rune(pattern)
+	inClass := false
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		next := func(offset int) rune {
+			if i+offset < len(runes) {
+				return runes[i+offset]
+			}
+			return 0
+		}
+		switch {
+		case c == '\\':
+			e := next(1)
+			i++
+			switch {
+			case e == 0:
+			case strings.ContainsRune("^$\\.*+?()[]{}|/", e):
+			case strings.ContainsRune("dDwWntrfvs", e):
+			case e == 'S' && !inClass:
+			case (e == 'b' || e == 'B') && !inClass:
+			case e == '-' && inClass:
+			case e >= '0' && e <= '9':
+				return "backreferences"
+			case inClass:
+				return "the escape \\" + string(e) + " inside a character class"
+			default:
+				return "the escape \\" + string(e)
+			}
+		case inClass:
+			if c == '[' {
+				return "an unescaped [ inside a character class"
+			}
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			if next(1) == '^' {
+				i++
+			}
+			if next(1) == ']' {
+				return "an unescaped ] at the start of a character class"
+			}
+		case c == '(' && next(1) == '?':
+			if next(2) == ':' {
+				i += 2
+			} else if next(2) == '=' || next(2) == '!' || (next(2) == '<' && (next(3) == '=' || next(3) == '!')) {
+				return "lookarounds"
+			} else if next(2) == '<' || next(2) == 'P' {
+				return "named groups"
+			} else {
+				return "inline flags"
+			}
+		case c == '{':
+			end := i + 1
+			for end < len(runes) && (runes[end] >= '0' && runes[end] <= '9' || runes[end] == ',') {
+				end++
+			}
+			counts := strings.Split(string(runes[i+1:end]), ",")
+			if end == len(runes) || runes[end] != '}' || len(counts) > 2 || counts[0] == "" {
+				return "an unescaped {"
+			}
+			for _, count := range counts {
+				if n, err := strconv.Atoi(count); count != "" && (err != nil || n > 1000) {
+					return "a repetition count over 1000"
+				}
+			}
+			i = end
+		case c == ']' || c == '}':
+			return "an unescaped " + string(c)
+		}
+	}
+	return ""
+}
+`
+
+const regexMatchHelper = `regexMatch := func(str string, indexes []int) any {
+	groups := []any{}
+	for i := 2; i < len(indexes); i += 2 {
+		if indexes[i] < 0 {
+			groups = append(groups, nil)
+		} else {
+			groups = append(groups, str[indexes[i]:indexes[i+1]])
+		}
+	}
+	return tenecs_regex_Match{
+		_text: str[indexes[0]:indexes[1]],
+		_groups: groups,
+	}
+}
+`
+
+func tenecs_regex_Match() Function {
+	return structFunction(standard_library.Tenecs_regex_Match)
+}
+func tenecs_regex_Regex() Function {
+	return structFunction(standard_library.Tenecs_regex_Regex)
+}
+func tenecs_regex_compile() Function {
+	return function(
+		imports("regexp", "strconv", "strings"),
+		params("pattern"),
+		body(regexUnsupportedSyntaxHelper+`p := pattern.(string)
+if unsupported := regexUnsupportedSyntax(p); unsupported != "" {
+	return tenecs_error_Error{
+		_message: strconv.Quote(p) + " is not a supported regex, it uses " + unsupported,
+		_details: []any{},
+	}
+}
+compiled, err := regexp.Compile(p)
+if err != nil {
+	return tenecs_error_Error{
+		_message: strconv.Quote(p) + " is not a valid regex",
+		_details: []any{},
+	}
+}
+return tenecs_regex_Regex{
+	_compiled: compiled,
+	_anchored: regexp.MustCompile("^(?:" + p + ")$"),
+}`),
+	)
+}
+func tenecs_regex_find() Function {
+	return function(
+		imports("regexp"),
+		params("regex", "str"),
+		body(regexMatchHelper+`s := str.(string)
+indexes := regex.(tenecs_regex_Regex)._compiled.(*regexp.Regexp).FindStringSubmatchIndex(s)
+if indexes == nil {
+	return nil
+}
+return regexMatch(s, indexes)`),
+	)
+}
+func tenecs_regex_findAll() Function {
+	return function(
+		imports("regexp"),
+		params("regex", "str"),
+		body(regexMatchHelper+`s := str.(string)
+result := []any{}
+for _, indexes := range regex.(tenecs_regex_Regex)._compiled.(*regexp.Regexp).FindAllStringSubmatchIndex(s, -1) {
+	result = append(result, regexMatch(s, indexes))
+}
+return result`),
+	)
+}
+func tenecs_regex_matches() Function {
+	return function(
+		imports("regexp"),
+		params("regex", "str"),
+		body(`return regex.(tenecs_regex_Regex)._anchored.(*regexp.Regexp).MatchString(str.(string))`),
+	)
+}
+func tenecs_regex_replaceAll() Function {
+	return function(
+		imports("regexp"),
+		params("regex", "str", "replacement"),
+		body(`return regex.(tenecs_regex_Regex)._compiled.(*regexp.Regexp).ReplaceAllLiteralString(str.(string), replacement.(string))`),
+	)
+}
@@ -175,3 +175,154 @@ return ""
 }`),
 	)
 }
+func tenecs_string_fromInt() Function {
+	return function(
+		imports("strconv"),
+		params("value"),
+		body("return strconv.Itoa(value.(int))"),
+	)
+}
+func tenecs_string_indexOf() Function {
+	return function(
+		imports("strings", "unicode/utf8"),
+		params("str", "subStr"),
+		body(`
+s := str.(string)
+index := strings.Index(s, subStr.(string))
+if index < 0 {
+return nil
+}
+return utf8.RuneCountInString(s[:index])`),
+	)
+}
+func tenecs_string_lines() Function {
+	return function(
+		imports("strings"),
+		params("str"),
+		body(`
+s := strings.TrimSuffix(strings.TrimSuffix(str.(string), "\n"), "\r")
+result := []any{}
+if s == "" && str.(string) == "" {
+return result
+}
+for _, line := range strings.Split(s, "\n") {
+result = append(result, strings.TrimSuffix(line, "\r"))
+}
+return result`),
+	)
+}
+func tenecs_string_replace() Function {
+	return function(
+		imports("strings"),
+		params("str", "target", "replacement"),
+		body("return strings.ReplaceAll(str.(string), target.(string), replacement.(string))"),
+	)
+}
+func tenecs_string_split() Function {
+	return function(
+		imports("strings"),
+		params("str", "separator"),
+		body(`
+result := []any{}
+for _, part := range strings.Split(str.(string), separator.(string)) {
+result = append(result, part)
+}
+return result`),
+	)
+}
+func tenecs_string_substring() Function {
+	return function(
+		params("str", "start", "end"),
+		body(`
+runes := []rune(str.(string))
+from := min(max(start.(int), 0), len(runes))
+until := min(max(end.(int), from), len(runes))
+return string(runes[from:until])`),
+	)
+}
+func tenecs_string_toInt() Function {
+	return function(
+		imports("errors", "strconv"),
+		params("str"),
+		body(`
+s := str.(string)
+result, err := strconv.ParseInt(s, 10, 64)
+if err != nil {
+message := strconv.Quote(s) + " is not an Int"
+if errors.Is(err, strconv.ErrRange) {
+message = strconv.Quote(s) + " is out of range for an Int"
+}
+return tenecs_error_Error{
+_message: message,
+_details: []any{},
+}
+}
+return int(result)`),
+	)
+}
+func tenecs_string_chars() Function {
+	return function(
+		params("str"),
+		body(`
+result := []any{}
+for _, r := range str.(string) {
+result = append(result, r)
+}
+return result`),
+	)
+}
+func tenecs_string_fromChars() Function {
+	return function(
+		params("chars"),
+		body(`
+runes := []rune{}
+for _, c := range chars.([]any) {
+runes = append(runes, c.(rune))
+}
+return string(runes)`),
+	)
+}
+func tenecs_string_graphemes() Function {
+	return function(
+		imports("unicode"),
+		params("str"),
+		body(`
+isRegionalIndicator := func(r rune) bool {
+return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+isExtend := func(r rune) bool {
+return unicode.Is(unicode.M, r) || r == 0x200D || (r >= 0x1F3FB && r <= 0x1F3FF) || (r >= 0xE0020 && r <= 0xE007F)
+}
+result := []any{}
+cluster := []rune{}
+regionalIndicators := 0
+for _, r := range str.(string) {
+if len(cluster) > 0 {
+prev := cluster[len(cluster)-1]
+together := false
+if prev == '\r' && r == '\n' {
+together = true
+} else if unicode.Is(unicode.Cc, prev) || unicode.Is(unicode.Cc, r) {
+together = false
+} else if isExtend(r) || prev == 0x200D {
+together = true
+} else if isRegionalIndicator(prev) && isRegionalIndicator(r) && regionalIndicators%2 == 1 {
+together = true
+}
+if !together {
+result = append(result, string(cluster))
+cluster = []rune{}
+regionalIndicators = 0
+}
+}
+cluster = append(cluster, r)
+if isRegionalIndicator(r) {
+regionalIndicators++
+}
+}
+if len(cluster) > 0 {
+result = append(result, string(cluster))
+}
+return result`),
+	)
+}
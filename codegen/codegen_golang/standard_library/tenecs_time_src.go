@@ -44,3 +44,255 @@ return tenecs_time_Date{
 }`),
 	)
 }
+func tenecs_time_DateTime() Function {
+	return structFunction(standard_library.Tenecs_time_DateTime)
+}
+func tenecs_time_Duration() Function {
+	return structFunction(standard_library.Tenecs_time_Duration)
+}
+func tenecs_time_Instant() Function {
+	return structFunction(standard_library.Tenecs_time_Instant)
+}
+func tenecs_time_durationBetween() Function {
+	return function(
+		params("from", "to"),
+		body(`return tenecs_time_Duration{
+  _milliseconds: to.(tenecs_time_Instant)._epochMilliseconds.(int) - from.(tenecs_time_Instant)._epochMilliseconds.(int),
+}`),
+	)
+}
+
+const timeLocationHelper = `timeLocation := func(timeZone string) (*time.Location, error) {
+if timeZone == "" || timeZone == "Local" {
+return nil, errors.New("unknown time zone " + timeZone)
+}
+return time.LoadLocation(timeZone)
+}
+`
+
+func tenecs_time_fromDateTime() Function {
+	return function(
+		imports("errors", "time", `_ "time/tzdata"`),
+		params("dateTime", "timeZone"),
+		body(timeLocationHelper+`location, err := timeLocation(timeZone.(string))
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+dt := dateTime.(tenecs_time_DateTime)
+date := dt._date.(tenecs_time_Date)
+t := time.Date(date._year.(int), time.Month(date._month.(int)), date._day.(int), dt._hour.(int), dt._minute.(int), dt._second.(int), dt._millisecond.(int)*int(time.Millisecond), location)
+return tenecs_time_Instant{
+  _epochMilliseconds: int(t.UnixMilli()),
+}`),
+	)
+}
+func tenecs_time_hours() Function {
+	return function(
+		params("amount"),
+		body(`return tenecs_time_Duration{
+  _milliseconds: amount.(int) * 60 * 60 * 1000,
+}`),
+	)
+}
+func tenecs_time_instantIsAfter() Function {
+	return function(
+		params("instant", "other"),
+		body(`return instant.(tenecs_time_Instant)._epochMilliseconds.(int) > other.(tenecs_time_Instant)._epochMilliseconds.(int)`),
+	)
+}
+func tenecs_time_instantIsBefore() Function {
+	return function(
+		params("instant", "other"),
+		body(`return instant.(tenecs_time_Instant)._epochMilliseconds.(int) < other.(tenecs_time_Instant)._epochMilliseconds.(int)`),
+	)
+}
+func tenecs_time_minusDuration() Function {
+	return function(
+		params("instant", "duration"),
+		body(`return tenecs_time_Instant{
+  _epochMilliseconds: instant.(tenecs_time_Instant)._epochMilliseconds.(int) - duration.(tenecs_time_Duration)._milliseconds.(int),
+}`),
+	)
+}
+func tenecs_time_minutes() Function {
+	return function(
+		params("amount"),
+		body(`return tenecs_time_Duration{
+  _milliseconds: amount.(int) * 60 * 1000,
+}`),
+	)
+}
+func tenecs_time_plusDuration() Function {
+	return function(
+		params("instant", "duration"),
+		body(`return tenecs_time_Instant{
+  _epochMilliseconds: instant.(tenecs_time_Instant)._epochMilliseconds.(int) + duration.(tenecs_time_Duration)._milliseconds.(int),
+}`),
+	)
+}
+func tenecs_time_seconds() Function {
+	return function(
+		params("amount"),
+		body(`return tenecs_time_Duration{
+  _milliseconds: amount.(int) * 1000,
+}`),
+	)
+}
+func tenecs_time_toDateTime() Function {
+	return function(
+		imports("errors", "time", `_ "time/tzdata"`),
+		params("instant", "timeZone"),
+		body(timeLocationHelper+`location, err := timeLocation(timeZone.(string))
+if err != nil {
+return tenecs_error_Error{_message: err.Error(), _details: []any{}}
+}
+t := time.UnixMilli(int64(instant.(tenecs_time_Instant)._epochMilliseconds.(int))).In(location)
+return tenecs_time_DateTime{
+  _date: tenecs_time_Date{
+    _year: t.Year(),
+    _month: int(t.Month()),
+    _day: t.Day(),
+  },
+  _hour: t.Hour(),
+  _minute: t.Minute(),
+  _second: t.Second(),
+  _millisecond: t.Nanosecond() / int(time.Millisecond),
+}`),
+	)
+}
+func tenecs_time_dayOfWeek() Function {
+	return function(
+		imports("time"),
+		params("date"),
+		body(`d := date.(tenecs_time_Date)
+weekday := time.Date(d._year.(int), time.Month(d._month.(int)), d._day.(int), 0, 0, 0, 0, time.UTC).Weekday()
+return (int(weekday)+6)%7 + 1`),
+	)
+}
+func tenecs_time_daysBetween() Function {
+	return function(
+		imports("time"),
+		params("from", "to"),
+		body(`f := from.(tenecs_time_Date)
+t := to.(tenecs_time_Date)
+fromTime := time.Date(f._year.(int), time.Month(f._month.(int)), f._day.(int), 0, 0, 0, 0, time.UTC)
+toTime := time.Date(t._year.(int), time.Month(t._month.(int)), t._day.(int), 0, 0, 0, 0, time.UTC)
+return int(toTime.Unix()-fromTime.Unix()) / (24 * 60 * 60)`),
+	)
+}
+func tenecs_time_format() Function {
+	return function(
+		imports("fmt"),
+		params("date"),
+		body(`d := date.(tenecs_time_Date)
+return fmt.Sprintf("%04d-%02d-%02d", d._year.(int), d._month.(int), d._day.(int))`),
+	)
+}
+func tenecs_time_isAfter() Function {
+	return function(
+		params("date", "other"),
+		body(`d := date.(tenecs_time_Date)
+o := other.(tenecs_time_Date)
+if d._year.(int) != o._year.(int) {
+  return d._year.(int) > o._year.(int)
+}
+if d._month.(int) != o._month.(int) {
+  return d._month.(int) > o._month.(int)
+}
+return d._day.(int) > o._day.(int)`),
+	)
+}
+func tenecs_time_isBefore() Function {
+	return function(
+		params("date", "other"),
+		body(`d := date.(tenecs_time_Date)
+o := other.(tenecs_time_Date)
+if d._year.(int) != o._year.(int) {
+  return d._year.(int) < o._year.(int)
+}
+if d._month.(int) != o._month.(int) {
+  return d._month.(int) < o._month.(int)
+}
+return d._day.(int) < o._day.(int)`),
+	)
+}
+
+const timeParseDateHelper = `parseDate := func(s string) (tenecs_time_Date, bool) {
+if len(s) != 10 || s[4] != '-' || s[7] != '-' {
+  return tenecs_time_Date{}, false
+}
+for i, c := range s {
+  if i != 4 && i != 7 && (c < '0' || c > '9') {
+    return tenecs_time_Date{}, false
+  }
+}
+parsed, err := time.Parse("2006-01-02", s)
+if err != nil {
+  return tenecs_time_Date{}, false
+}
+return tenecs_time_Date{
+  _year: parsed.Year(),
+  _month: int(parsed.Month()),
+  _day: parsed.Day(),
+}, true
+}
+`
+
+func tenecs_time_jsonDate() Function {
+	return function(
+		imports("encoding/json", "fmt", "strings", "time"),
+		body(jsonExpectedHelper+timeParseDateHelper+`return tenecs_json_JsonConverter{
+	_fromJson: func(input any) any {
+		jsonString, _ := input.(string)
+		var text string
+		err := json.Unmarshal([]byte(jsonString), &text)
+		if err != nil {
+			return jsonExpected("Date", input)
+		}
+		date, ok := parseDate(text)
+		if !ok {
+			return jsonExpected("Date", input)
+		}
+		return date
+	},
+	_toJson: func(input any) any {
+		d := input.(tenecs_time_Date)
+		return fmt.Sprintf("\"%04d-%02d-%02d\"", d._year.(int), d._month.(int), d._day.(int))
+	},
+}`),
+	)
+}
+func tenecs_time_parse() Function {
+	return function(
+		imports("time"),
+		params("text"),
+		body(timeParseDateHelper+`date, ok := parseDate(text.(string))
+if !ok {
+  return tenecs_error_Error{_message: "Could not parse Date from " + text.(string), _details: []any{}}
+}
+return date`),
+	)
+}
+func tenecs_time_plusMonths() Function {
+	return function(
+		imports("time"),
+		params("date", "months"),
+		body(`d := date.(tenecs_time_Date)
+total := d._year.(int)*12 + d._month.(int) - 1 + months.(int)
+year := total / 12
+if total%12 < 0 {
+  year -= 1
+}
+month := total - year*12 + 1
+day := d._day.(int)
+lastDay := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+if day > lastDay {
+  day = lastDay
+}
+return tenecs_time_Date{
+  _year: year,
+  _month: month,
+  _day: day,
+}`),
+	)
+}
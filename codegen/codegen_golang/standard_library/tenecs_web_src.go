@@ -14,3 +14,9 @@ func tenecs_web_HtmlElementProperty() Function {
 func tenecs_web_CssUrl() Function {
 	return structFunction(standard_library.Tenecs_web_CssUrl)
 }
+func tenecs_web_httpGet() Function {
+	return unavailable("only available in web apps")
+}
+func tenecs_web_httpPost() Function {
+	return unavailable("only available in web apps")
+}
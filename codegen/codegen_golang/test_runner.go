@@ -5,7 +5,7 @@ import "fmt"
 func GenerateTestRunner() ([]Import, string) {
 	imports, runtime := GenerateRuntime()
 
-	imports = append(imports, "fmt", "reflect")
+	imports = append(imports, "bufio", "context", "fmt", "net", "net/http", "net/http/httptest", "path/filepath", "reflect", "slices", "sort", "strconv", "strings")
 
 	ref := runtimeRefCreator()
 
@@ -24,6 +24,9 @@ cachedUnitTestSuiteOk int
 
 var testSummary = testSummaryStruct{}
 
+var runtimeRandomSeed int64
+var runtimeRandomUsed atomic.Bool
+
 func runTests(implementingUnitTestSuite []any, implementingUnitTest []any, implementingGoIntegrationTest []any) {
 	registry := createTestRegistry()
 
@@ -44,16 +47,20 @@ func runTests(implementingUnitTestSuite []any, implementingUnitTest []any, imple
 	}
 	for _, implementation := range implementingGoIntegrationTest {
 		r := runtime()
-		testkit := createGoIntegrationTestKit()
+		testkit, closeTestKit := createGoIntegrationTestKit()
 		registry._test.(func(any, any) any)(implementation.(tenecs_test_GoIntegrationTest)._name, func (_ any) any {
 			implementation.(tenecs_test_GoIntegrationTest)._theTest.(func(any,any) any)(testkit, r)
 			return nil
 		})
+		closeTestKit()
 	}
 
 	fmt.Printf("\nRan a total of %d tests\n", testSummary.runTotal)
 	fmt.Printf("  * %d succeeded\n", testSummary.runOk)
 	fmt.Printf("  * %d failed\n", testSummary.runFail)
+	if testSummary.runFail > 0 && runtimeRandomUsed.Load() {
+		fmt.Printf("Random seed was %d (rerun with --seed %d)\n", runtimeRandomSeed, runtimeRandomSeed)
+	}
 	if testSummary.cachedUnitTestOk > 0 {
 		fmt.Printf("Skipped %d successful unit tests cached\n", testSummary.cachedUnitTestOk)
 	}
@@ -62,53 +69,77 @@ func runTests(implementingUnitTestSuite []any, implementingUnitTest []any, imple
 	}
 }
 
-func createGoIntegrationTestKit() tenecs_test_GoIntegrationTestKit {
+// createGoIntegrationTestKit also returns a function closing the servers of the fake http, to be called once the test is done.
+func createGoIntegrationTestKit() (tenecs_test_GoIntegrationTestKit, func()) {
+	var serversLock sync.Mutex
+	servers := []*httptest.Server{}
+	onServer := func(server *httptest.Server) {
+		serversLock.Lock()
+		defer serversLock.Unlock()
+		servers = append(servers, server)
+	}
+	closeTestKit := func() {
+		serversLock.Lock()
+		defer serversLock.Unlock()
+		for _, server := range servers {
+			server.Close()
+		}
+	}
+
 	assert := tenecs_test_Assert{
 		_equal: func(codePoint any, expected any, value any) any {
 			if !reflect.DeepEqual(value, expected) {
-				panic(testEqualityErrorMessage(codePoint, value, expected))
+				panic(tenecsTestFailure(testEqualityErrorMessage(codePoint, value, expected)))
 			}
 			return nil
 		},
 		_fail: func(codePoint any, message any) any {
-			panic("@" + codePoint.(string) + ": " + message.(string))
+			panic(tenecsTestFailure("@" + codePoint.(string) + ": " + message.(string)))
 		},
 	}
 
 	testkit := tenecs_test_GoIntegrationTestKit{
-		_assert: assert,
+		_assert:      assert,
+		_fakeClock:      ` + runtimeFakeClock() + `,
+		_fakeConcurrent: ` + runtimeFakeConcurrent() + `,
+		_fakeConsole:    ` + runtimeFakeConsole() + `,
+		_fakeFs:         ` + runtimeFakeFileSystem() + `,
+		_fakeHttp: func(handler any) any {
+			return ` + runtimeHttpFake("handler", "onServer") + `
+		},
+		_fakeProcess:    ` + runtimeFakeProcess() + `,
 	}
-	return testkit
+	return testkit, closeTestKit
 }
 
 func createTestRegistry() tenecs_test_UnitTestRegistry {
 	assert := tenecs_test_Assert{
 		_equal: func(codePoint any, expected any, value any) any {
 			if !reflect.DeepEqual(value, expected) {
-				panic(testEqualityErrorMessage(codePoint, value, expected))
+				panic(tenecsTestFailure(testEqualityErrorMessage(codePoint, value, expected)))
 			}
 			return nil
 		},
 		_fail: func(codePoint any, message any) any {
-			panic("@" + codePoint.(string) + ": " + message.(string))
+			panic(tenecsTestFailure("@" + codePoint.(string) + ": " + message.(string)))
 		},
-	}` + fmt.Sprintf(`
-
-	testkit := tenecs_test_UnitTestKit{
-		_assert: assert,
-		_ref: %s,
-	}`, ref) + `
+	}
 
 	return tenecs_test_UnitTestRegistry{
 		_test: func(name any, theTest any) any {
 			testName := name.(string)
-			testFunc := theTest.(func(any) any)
+			testFunc := theTest.(func(any) any)` + fmt.Sprintf(`
+			testkit := tenecs_test_UnitTestKit{
+				_assert: assert,
+				_fakeClock: %s,
+				_ref: %s,
+			}`, runtimeFakeClock(), ref) + `
 			testSuccess := true
 			defer func() {
 				errMsg := "could not print the failure"
-				if err := recover(); err != nil {
+				if err := tenecsRecovered(recover()); err != nil {
 					testSuccess = false
-					errMsg = err.(string)
+					errMsg = tenecsPanicMessage(err, testName)
 				}
 				testResultString := "[\u001b[32mOK\u001b[0m]"
 				if !testSuccess {
@@ -119,7 +150,7 @@ func createTestRegistry() tenecs_test_UnitTestRegistry {
 				}
 				fmt.Printf("  %s %s\n", testResultString, testName)
 				if !testSuccess {
-					fmt.Printf("    %s\n", errMsg)
+					fmt.Printf("    %s\n", strings.ReplaceAll(errMsg, "\n", "\n    "))
 				}
 				testSummary.runTotal += 1
 			}()
@@ -130,7 +161,22 @@ func createTestRegistry() tenecs_test_UnitTestRegistry {
 }
 
 func testEqualityErrorMessage(codePoint any, value any, expected any) string {
-	return fmt.Sprintf("@%s: %+v is not equal to %+v", codePoint, expected, value)
+	return fmt.Sprintf("@%s: %s is not equal to %s", codePoint, testFormat(expected), testFormat(value))
+}
+
+// testFormat shows a Char as the character it is, the same way the js test runner does, instead of its code point
+func testFormat(value any) string {
+	switch v := value.(type) {
+	case rune:
+		return "'" + string(v) + "'"
+	case []any:
+		elems := []string{}
+		for _, elem := range v {
+			elems = append(elems, testFormat(elem))
+		}
+		return "[" + strings.Join(elems, " ") + "]"
+	}
+	return fmt.Sprintf("%+v", value)
 }
 `
 
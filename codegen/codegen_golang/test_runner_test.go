@@ -9,6 +9,7 @@ import (
 	"github.com/xplosunn/tenecs/external/golang"
 	"github.com/xplosunn/tenecs/parser"
 	"github.com/xplosunn/tenecs/typer"
+	"strings"
 	"testing"
 )
 
@@ -47,3 +48,159 @@ Ran a total of 1 tests
 `, codegen_golang.Red("FAILURE"))
 	assert.Equal(t, expectedResult, result)
 }
+
+func TestCharEqualityError(t *testing.T) {
+	program := `package test
+
+import tenecs.test.UnitTest
+import tenecs.test.UnitTestKit
+
+_ := UnitTest("chars", (testkit: UnitTestKit): Void => {
+  testkit.assert.equal('a', 'b')
+})
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramTest(typed, codegen.FindTests(typed))
+
+	result := golang.RunCodeUnlessCached(t, generated)
+
+	expectedResult := fmt.Sprintf(`unit tests:
+  [%s] chars
+    @file.10x:7: 'a' is not equal to 'b'
+
+Ran a total of 1 tests
+  * 0 succeeded
+  * 1 failed
+`, codegen_golang.Red("FAILURE"))
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestRuntimeErrorWithStackTrace(t *testing.T) {
+	program := `package test
+
+import tenecs.string.repeat
+import tenecs.test.UnitTest
+import tenecs.test.UnitTestKit
+
+_ := UnitTest("repeats backwards", (testkit: UnitTestKit): Void => {
+  testkit.assert.equal("", repeatBackwards("a"))
+})
+
+repeatBackwards := (s: String): String => {
+  count := -1
+  repeat(s, count)
+}
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramTest(typed, codegen.FindTests(typed))
+
+	result := golang.RunCodeUnlessCached(t, generated)
+
+	expectedResult := fmt.Sprintf(`unit tests:
+  [%s] repeats backwards
+    strings: negative Repeat count
+      at test.repeatBackwards (file.10x:13)
+      at repeats backwards (file.10x:8)
+
+Ran a total of 1 tests
+  * 0 succeeded
+  * 1 failed
+`, codegen_golang.Red("FAILURE"))
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestRuntimeErrorWithStackTraceThroughLambda(t *testing.T) {
+	program := `package test
+
+import tenecs.list.map
+import tenecs.string.repeat
+import tenecs.test.UnitTest
+import tenecs.test.UnitTestKit
+
+_ := UnitTest("repeats inside a lambda", (testkit: UnitTestKit): Void => {
+  result := ["a"]->map((s: String): String => {
+    repeat(s, -1)
+  })
+  testkit.assert.equal(<String>[], result)
+})
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramTest(typed, codegen.FindTests(typed))
+
+	result := golang.RunCodeUnlessCached(t, generated)
+
+	expectedResult := fmt.Sprintf(`unit tests:
+  [%s] repeats inside a lambda
+    strings: negative Repeat count
+      at repeats inside a lambda (file.10x:10)
+      at repeats inside a lambda (file.10x:9)
+
+Ran a total of 1 tests
+  * 0 succeeded
+  * 1 failed
+`, codegen_golang.Red("FAILURE"))
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestRandomSeedIsReportedAndReproducible(t *testing.T) {
+	program := `package test
+
+import tenecs.go.Runtime
+import tenecs.test.GoIntegrationTest
+import tenecs.test.GoIntegrationTestKit
+
+_ := GoIntegrationTest("random", "lucky", (testkit: GoIntegrationTestKit, runtime: Runtime) => {
+  testkit.assert.equal("", runtime.random.uuid())
+})
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_golang.GenerateProgramTest(typed, codegen.FindTests(typed))
+
+	result, err := golang.RunCodeWithEnvBlockingAndReturningOutputWhenFinished(generated, []string{"TENECS_SEED=42"})
+	assert.NoError(t, err)
+	assert.Contains(t, result, "Random seed was 42 (rerun with --seed 42)\n")
+
+	rerun, err := golang.RunCodeWithEnvBlockingAndReturningOutputWhenFinished(generated, []string{"TENECS_SEED=42"})
+	assert.NoError(t, err)
+	assert.Equal(t, result, rerun)
+
+	otherSeed, err := golang.RunCodeWithEnvBlockingAndReturningOutputWhenFinished(generated, []string{"TENECS_SEED=7"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, strings.ReplaceAll(result, "42", "7"), otherSeed)
+}
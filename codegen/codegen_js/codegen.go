@@ -13,14 +13,19 @@ import (
 )
 
 func GenerateProgramNonRunnable(program *ast.Program) string {
-	return generateProgram(program)
+	code, _ := GenerateProgramNonRunnableWithSourceMap(program)
+	return code
 }
 
-func GenerateHtmlPageForWebApp(program *ast.Program, targetWebApp ast.Ref, cssFiles []string) string {
+func GenerateHtmlPageForWebApp(program *ast.Program, targetWebApp ast.Ref, cssFiles []string, externalJsFiles map[string]string) string {
 	cssChildren := ""
 	for _, cssFile := range cssFiles {
 		cssChildren += fmt.Sprintf(`<link rel="stylesheet" type="text/css" href="%s">`, cssFile)
 	}
+	externalJsScript := ""
+	if len(externalJsFiles) > 0 {
+		externalJsScript = generateTagWithoutAttributes("script", GenerateExternalJsModules(externalJsFiles))
+	}
 	return generateTagWithoutAttributes(
 		"html",
 		generateTagWithoutAttributes(
@@ -28,7 +33,7 @@ func GenerateHtmlPageForWebApp(program *ast.Program, targetWebApp ast.Ref, cssFi
 			cssChildren,
 		)+generateTagWithoutAttributes(
 			"body",
-			`<div id="toplevel_tenecs_webapp_container"></div>`+generateTagWithoutAttributes(
+			`<div id="toplevel_tenecs_webapp_container"></div>`+externalJsScript+generateTagWithoutAttributes(
 				"script",
 				generateJsOfWebApp(program, targetWebApp),
 			),
@@ -41,8 +46,11 @@ func generateTagWithoutAttributes(tagName string, children string) string {
 }
 
 func generateJsOfWebApp(program *ast.Program, targetWebApp ast.Ref) string {
-	result := GenerateProgramNonRunnable(program) + "\n"
+	programJs, sourceMap := GenerateProgramNonRunnableWithSourceMap(program)
+	result := programJs + "\n"
 	result += generateWebAppJsMain(targetWebApp.Package, targetWebApp.Name)
+	result += "//# sourceURL=webapp.js\n"
+	result += sourceMap.InlineComment() + "\n"
 	return result
 }
 
@@ -143,6 +151,12 @@ func generateProgram(program *ast.Program) string {
 		decs += generateStructFunction(&structFuncName.Package, structFuncName.Name, structFunc) + "\n"
 	}
 
+	externalNames := maps.Keys(program.ExternalFunctions)
+	ast.SortRefs(externalNames)
+	for _, externalName := range externalNames {
+		decs += generateExternalFunction(&externalName.Package, externalName.Name, program.ExternalFunctions[externalName]) + "\n"
+	}
+
 	nativeFuncNames := maps.Keys(program.NativeFunctions)
 	ast.SortRefs(nativeFuncNames)
 	for _, nativeFuncName := range nativeFuncNames {
@@ -153,6 +167,14 @@ func generateProgram(program *ast.Program) string {
 		decs += fmt.Sprintf("function %s%s", variableName(&nativeFuncName.Package, nativeFuncName.Name), f.Code) + "\n"
 	}
 
+	if _, ok := program.NativeFunctions[ast.Ref{Package: "tenecs_json", Name: "jsonConverter"}]; ok {
+		derived, err := codegen.DeriveJsonTypes(program)
+		if err != nil {
+			panic(err)
+		}
+		decs = generateDerivedJsonTypes(derived) + decs
+	}
+
 	main := ""
 
 	result := decs + "\n" + main
@@ -160,6 +182,30 @@ func generateProgram(program *ast.Program) string {
 	return result
 }
 
+// generateDerivedJsonTypes declares what tenecs_json__jsonConverter looks up by type name.
+func generateDerivedJsonTypes(derived map[string]codegen.DerivedJsonType) string {
+	result := "const tenecsJsonDerivedTypes = {\n"
+	for _, name := range codegen.SortedDerivedJsonTypeNames(derived) {
+		derivedType := derived[name]
+		of := []string{}
+		for _, typeName := range derivedType.Of {
+			of = append(of, strconv.Quote(typeName))
+		}
+		if derivedType.Kind != codegen.DerivedJsonStruct {
+			result += fmt.Sprintf("  %s: { \"kind\": %s, \"of\": [%s] },\n", strconv.Quote(name), strconv.Quote(string(derivedType.Kind)), strings.Join(of, ", "))
+			continue
+		}
+		fields := []string{}
+		for _, field := range derivedType.Fields {
+			fields = append(fields, strconv.Quote(field.Name))
+			of = append(of, strconv.Quote(field.TypeName))
+		}
+		result += fmt.Sprintf("  %s: { \"kind\": \"Struct\", \"type\": %s, \"fields\": [%s], \"of\": [%s] },\n", strconv.Quote(name), strconv.Quote(derivedType.Struct.Name), strings.Join(fields, ", "), strings.Join(of, ", "))
+	}
+	result += "}\n"
+	return result
+}
+
 func generateStructFunction(pkgName *string, name string, structFunc *types.Function) string {
 	result := "function " + variableName(pkgName, name)
 	result += "("
@@ -195,6 +241,10 @@ func generateDeclaration(pkgName *string, declaration *ast.Declaration, structTy
 }
 
 func generateExpression(pkgName *string, expression ast.Expression, structTypeArgumentMatchFields map[ast.Ref][]string) string {
+	return sourceMapMarker(expression.SourceCodePoint()) + generateExpressionWithoutSourceMap(pkgName, expression, structTypeArgumentMatchFields)
+}
+
+func generateExpressionWithoutSourceMap(pkgName *string, expression ast.Expression, structTypeArgumentMatchFields map[ast.Ref][]string) string {
 	caseLiteral, caseReference, caseAccess, caseInvocation, caseFunction, caseDeclaration, caseIf, caseList, caseWhen := expression.ExpressionCases()
 	if caseLiteral != nil {
 		return generateLiteral(*caseLiteral)
@@ -262,6 +312,9 @@ func generateExpressionsWithinBlock(pkgName *string, block []ast.Expression, str
 
 func generateInvocation(pkgName *string, invocation ast.Invocation, structTypeArgumentMatchFields map[ast.Ref][]string) string {
 	result := generateExpression(pkgName, invocation.Over, structTypeArgumentMatchFields)
+	if derivedType, ok := codegen.JsonConverterDerivedType(invocation); ok {
+		return result + "(" + strconv.Quote(codegen.DerivedJsonTypeName(derivedType)) + ")"
+	}
 	result += "("
 	for i, argument := range invocation.Arguments {
 		if i > 0 {
@@ -289,8 +342,14 @@ func generateReference(pkgName *string, reference ast.Reference) string {
 func generateWhen(pkgName *string, when ast.When, structTypeArgumentMatchFields map[ast.Ref][]string) string {
 	result := "(() => {\n"
 	result += "let __over = " + generateExpression(pkgName, when.Over, structTypeArgumentMatchFields) + "\n"
+	// an integral Float is the same js number as an Int, so when the value can be an Int those are matched as Int
+	overCanBeInt := types.VariableTypeContainedIn(types.Int(), ast.VariableTypeOfExpression(when.Over))
 	for _, whenCase := range when.Cases {
-		result += "if (" + generateWhenClause(whenCase.VariableType, "__over", structTypeArgumentMatchFields) + ") {\n"
+		clause := generateWhenClause(whenCase.VariableType, "__over", structTypeArgumentMatchFields)
+		if overCanBeInt && types.VariableTypeEq(whenCase.VariableType, types.Float()) {
+			clause += " && !Number.isInteger(__over)"
+		}
+		result += "if (" + clause + ") {\n"
 		varName := whenCase.Name
 		if varName != nil {
 			result += "let " + variableName(pkgName, *varName) + " = __over\n"
@@ -325,6 +384,14 @@ func generateWhenClauseKnownType(knownType types.KnownType, varName string, stru
 			return "typeof " + varName + `=== "string"`
 		} else if knownType.Name == "Boolean" {
 			return "typeof " + varName + `=== "boolean"`
+		} else if knownType.Name == "Int" {
+			return "(Number.isInteger(" + varName + `) || typeof ` + varName + `=== "bigint")`
+		} else if knownType.Name == "Float" {
+			return "typeof " + varName + `=== "number"`
+		} else if knownType.Name == "Char" {
+			return "typeof " + varName + `=== "object" && ` + varName + ` !== null && ` + varName + `["$type"] === "Char"`
+		} else if knownType.Name == "Void" {
+			return varName + ` === null`
 		} else {
 			panic("TODO generateWhenClauseKnownType " + knownType.Name)
 		}
@@ -346,7 +413,7 @@ func generateWhenClauseKnownType(knownType types.KnownType, varName string, stru
   return %s
 })()`, nestedVarName, varName, matchFieldName, generateWhenClause(generic, nestedVarName, structTypeArgumentMatchFields))
 		}
-		return fmt.Sprintf(`typeof %s === "object" && %s["$type"] === "%s"`, varName, varName, knownType.Name) + additionalClauses
+		return fmt.Sprintf(`typeof %s === "object" && %s !== null && %s["$type"] === "%s"`, varName, varName, varName, knownType.Name) + additionalClauses
 	}
 }
 
@@ -369,13 +436,25 @@ func generateIf(pkgName *string, astIf ast.If, structTypeArgumentMatchFields map
 	return "(" + condition + ") ? (() => " + thenBlock + ")() : (() => " + elseBlock + ")()"
 }
 
+// maxSafeInteger is Number.MAX_SAFE_INTEGER, an Int beyond it is a BigInt at runtime.
+const maxSafeInteger = 1<<53 - 1
+
 func generateLiteral(literal ast.Literal) string {
 	result := ""
 	parser.LiteralExhaustiveSwitch(
 		literal.Literal,
 		func(literal float64) { result = fmt.Sprintf("%f", literal) },
-		func(literal int) { result = fmt.Sprintf("%d", literal) },
+		func(literal int) {
+			result = fmt.Sprintf("%d", literal)
+			if literal > maxSafeInteger || literal < -maxSafeInteger {
+				result += "n"
+			}
+		},
 		func(literal string) { result = literal },
+		func(literal string) {
+			unquoted, _ := strconv.Unquote(literal)
+			result = fmt.Sprintf(`({"$type": "Char", "codePoint": %d})`, []rune(unquoted)[0])
+		},
 		func(literal bool) { result = strconv.FormatBool(literal) },
 		func() { result = "null" },
 	)
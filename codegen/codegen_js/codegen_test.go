@@ -33,10 +33,16 @@ return ({
 })
 return null
 }
-function tenecs_go__Runtime(console, ref, time) {
+function tenecs_go__Runtime(concurrent, console, fs, http, logger, process, random, ref, time) {
 return ({
   "$type": "Runtime",
+  "concurrent": concurrent,
   "console": console,
+  "fs": fs,
+  "http": http,
+  "logger": logger,
+  "process": process,
+  "random": random,
   "ref": ref,
   "time": time,
 })
@@ -79,10 +85,11 @@ return ({
 })
 return null
 }
-function tenecs_test__UnitTestKit(assert, ref) {
+function tenecs_test__UnitTestKit(assert, fakeClock, ref) {
 return ({
   "$type": "UnitTestKit",
   "assert": assert,
+  "fakeClock": fakeClock,
   "ref": ref,
 })
 return null
@@ -150,19 +157,48 @@ function areDeeplyEqual(obj1, obj2) {
   return false;
 }
 
-let testkit = {
-  "assert": {
-    "equal": (expected, value) => {
-      if (!areDeeplyEqual(expected, value)) {
-        throw new Error(testEqualityErrorMessage(expected, value))
+function createUnitTestKit() {
+  return ({
+    "assert": {
+      "equal": (expected, value) => {
+        if (!areDeeplyEqual(expected, value)) {
+          throw new Error(testEqualityErrorMessage(expected, value))
+        }
+        return null
+      },
+      "fail": (message) => {
+        throw new Error(message)
       }
+    },
+    "fakeClock": (() => {
+  let now = 0
+  return ({
+    "$type": "FakeClock",
+    "advance": (duration) => {
+      now += duration.milliseconds
       return null
     },
-    "fail": (message) => {
-      throw new Error(message)
-    }
-  },
-  "ref": ({
+    "set": (instant) => {
+      now = instant.epochMilliseconds
+      return null
+    },
+    "time": ({
+      "$type": "Time",
+      "now": () => {
+        return ({ "$type": "Instant", "epochMilliseconds": now })
+      },
+      "sleep": (duration) => {
+        now += duration.milliseconds
+        return null
+      },
+      "today": () => {
+        const date = new Date(now)
+        return ({ "$type": "Date", "year": date.getUTCFullYear(), "month": date.getUTCMonth() + 1, "day": date.getUTCDate() })
+      }
+    })
+  })
+})(),
+    "ref": ({
   "new": (value) => {
     let ref = value
     return ({
@@ -181,13 +217,14 @@ let testkit = {
     })
   }
 })
+  })
 }
 
 function createTestRegistry() {
   return ({
     "test": (name, theTest) => {
       try {
-        theTest(testkit)
+        theTest(createUnitTestKit())
         console.log("  [\u001b[32mOK\u001b[0m]", name)
         testSummary.runOk += 1
       } catch (e) {
@@ -205,7 +242,16 @@ function createTestRegistry() {
 }
 
 function testEqualityErrorMessage(value, expected) {
-  return JSON.stringify(value) + " is not equal to " + JSON.stringify(expected)
+  const isChar = (value) => value !== null && typeof value === "object" && value["$type"] === "Char"
+  const format = (value) => isChar(value) ? "'" + String.fromCodePoint(value.codePoint) + "'" : JSON.stringify(value, (key, value) => {
+    if (typeof value === "bigint") {
+      return value.toString()
+    } else if (isChar(value)) {
+      return "'" + String.fromCodePoint(value.codePoint) + "'"
+    }
+    return value
+  })
+  return format(value) + " is not equal to " + format(expected)
 }
 
 runUnitTests([], [test__syntheticName_0])
@@ -331,6 +377,8 @@ function render(htmlElement) {
 }
 
 renderCurrentWebAppState()
+//# sourceURL=webapp.js
+//# sourceMappingURL=data:application/json;charset=utf-8;base64,eyJ2ZXJzaW9uIjozLCJzb3VyY2VzIjpbImZpbGUuMTB4Il0sIm5hbWVzIjpbXSwibWFwcGluZ3MiOiI7T0FpQkU7OztPQUlBLHdCQUFZLEtBQWtDLElBQUc7O3FCQVp6QyxtQkFDRDtPQUFNO0dBQ0osZ0JBQ0YsY0FDSTs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7Ozs7In0=
 </script></body></html>`
 
 	parsed, err := parser.ParseString(program)
@@ -345,6 +393,6 @@ renderCurrentWebAppState()
 	generated := codegen_js.GenerateHtmlPageForWebApp(typed, ast.Ref{
 		Package: "mypage",
 		Name:    "webApp",
-	}, nil)
+	}, nil, nil)
 	assert.Equal(t, expectedHtml, generated)
 }
@@ -39,7 +39,7 @@ func Test(t *testing.T) {
 				generatedHtml := codegen_js.GenerateHtmlPageForWebApp(typed, ast.Ref{
 					Package: "mypage",
 					Name:    "webApp",
-				}, nil)
+				}, nil, nil)
 				assert.Equal(t, html, generatedHtml)
 			})
 		}
@@ -0,0 +1,104 @@
+package codegen_js
+
+import (
+	"fmt"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"github.com/xplosunn/tenecs/typer/types"
+	"golang.org/x/exp/maps"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ExternalJsFilePaths lists the files implementing the js external functions of the program.
+// The module of an external is relative to the .10x file declaring it.
+func ExternalJsFilePaths(program *ast.Program) []string {
+	paths := map[string]bool{}
+	for _, external := range program.ExternalFunctions {
+		if external.Target == "js" {
+			paths[externalJsFilePath(external)] = true
+		}
+	}
+	result := maps.Keys(paths)
+	sort.Strings(result)
+	return result
+}
+
+// GenerateExternalJsModules generates the code defining the contents of the js files (by path) as CommonJS modules,
+// which needs to run before the program.
+func GenerateExternalJsModules(externalJsFiles map[string]string) string {
+	paths := maps.Keys(externalJsFiles)
+	sort.Strings(paths)
+	result := ""
+	for _, path := range paths {
+		result += fmt.Sprintf(`const %s = (function () {
+const module = { exports: {} };
+const exports = module.exports;
+%s
+return module.exports;
+})();
+`, externalJsModuleVariableName(path), externalJsFiles[path])
+	}
+	return result
+}
+
+func externalJsFilePath(external ast.ExternalFunction) string {
+	return filepath.Join(filepath.Dir(external.CodePoint.FileName), external.Module)
+}
+
+func externalJsModuleVariableName(path string) string {
+	result := "tenecs_external_"
+	for _, r := range path {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			result += string(r)
+		} else {
+			result += "_"
+		}
+	}
+	return result
+}
+
+func generateExternalFunction(pkgName *string, name string, external ast.ExternalFunction) string {
+	params := []string{}
+	for i, _ := range external.VariableType.Arguments {
+		params = append(params, fmt.Sprintf("arg%d", i))
+	}
+	result := fmt.Sprintf("function %s(%s) {\n", variableName(pkgName, name), strings.Join(params, ", "))
+	if external.Target != "js" {
+		result += fmt.Sprintf("throw new Error(%s)\n", strconv.Quote("external "+name+" is only available in "+external.Target))
+		return result + "}"
+	}
+
+	returnsVoid := types.VariableTypeEq(external.VariableType.ReturnType, types.Void())
+	_, _, _, _, caseOr := external.VariableType.ReturnType.VariableTypeCases()
+	if caseOr != nil {
+		for _, element := range caseOr.Elements {
+			if types.VariableTypeEq(element, types.Void()) {
+				returnsVoid = true
+			}
+		}
+	}
+
+	invocation := fmt.Sprintf("%s[%s](%s)", externalJsModuleVariableName(externalJsFilePath(external)), strconv.Quote(external.Symbol), strings.Join(params, ", "))
+	if returnsVoid {
+		invocation = "(" + invocation + ", null)"
+	}
+	if caseOr != nil {
+		result += fmt.Sprintf(`try {
+  return %s
+} catch (e) {
+  return ({
+    "$type": "Error",
+    "message": e instanceof Error ? e.message : String(e),
+    "cause": null,
+    "code": null,
+    "details": []
+  })
+}
+`, invocation)
+	} else {
+		result += fmt.Sprintf("return %s\n", invocation)
+	}
+	return result + "}"
+}
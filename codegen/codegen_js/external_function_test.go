@@ -0,0 +1,108 @@
+package codegen_js_test
+
+import (
+	"github.com/alecthomas/assert/v2"
+	"github.com/xplosunn/tenecs/codegen"
+	"github.com/xplosunn/tenecs/codegen/codegen_js"
+	"github.com/xplosunn/tenecs/desugar"
+	"github.com/xplosunn/tenecs/external/node"
+	"github.com/xplosunn/tenecs/parser"
+	"github.com/xplosunn/tenecs/typer"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"testing"
+)
+
+func TestExternalJsFunctions(t *testing.T) {
+	program := `package test
+
+import tenecs.error.Error
+import tenecs.test.UnitTest
+import tenecs.test.UnitTestKit
+
+external shout: (s: String) ~> String = js("strings.js", "shout")
+external words: (s: String) ~> List<String> = js("strings.js", "words")
+external parseNumber: (s: String) ~> Int | Error = js("strings.js", "parseNumber")
+external remember: (s: String) ~> Void = js("strings.js", "remember")
+
+_ := UnitTest("externals", (testkit: UnitTestKit): Void => {
+  testkit.assert.equal("HI!", shout("hi"))
+  testkit.assert.equal<List<String>>(["a", "b"], words(" a  b "))
+  testkit.assert.equal<Int | Error>(42, parseNumber("42"))
+  testkit.assert.equal<Int | Error>(Error("not a number: x"), parseNumber("x"))
+  testkit.assert.equal<Void>(null, remember("x"))
+})`
+	externalJsFiles := map[string]string{
+		"strings.js": `exports.shout = (s) => s.toUpperCase() + "!"
+exports.words = (s) => s.trim().split(/\s+/)
+exports.parseNumber = (s) => {
+  const n = Number.parseInt(s)
+  if (Number.isNaN(n)) {
+    throw new Error("not a number: " + s)
+  }
+  return n
+}
+exports.remember = (s) => undefined`,
+	}
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"strings.js"}, codegen_js.ExternalJsFilePaths(typed))
+
+	js := codegen_js.GenerateExternalJsModules(externalJsFiles) + codegen_js.GenerateProgramTest(typed, codegen.FindTests(typed))
+	output, err := node.RunCodeBlockingAndReturningOutputWhenFinished(t, js)
+	assert.NoError(t, err)
+	expectedOutput := "unit tests:\n  [\u001b[32mOK\u001b[0m] externals\nRan a total of 1 tests\n  * 1 succeeded\n  * 0 failed\n"
+	assert.Equal(t, expectedOutput, output)
+}
+
+func TestGenerateHtmlPageForWebAppBundlesExternalJs(t *testing.T) {
+	program := `package mypage
+
+import tenecs.web.HtmlElement
+import tenecs.web.HtmlElementProperty
+import tenecs.web.WebApp
+
+struct State()
+struct Event()
+
+external readStorage: (key: String) ~> String = js("storage.js", "read")
+
+webApp := WebApp<State, Event>(
+  init = () => State(),
+  update = (model: State, event: Event): State => model,
+  view = (model: State): HtmlElement<Event> => HtmlElement("p", <HtmlElementProperty<Event>>[], readStorage("greeting")),
+  external = null
+)`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated := codegen_js.GenerateHtmlPageForWebApp(typed, ast.Ref{
+		Package: "mypage",
+		Name:    "webApp",
+	}, nil, map[string]string{
+		"storage.js": `exports.read = (key) => localStorage.getItem(key)`,
+	})
+	expectedExternalScript := `<div id="toplevel_tenecs_webapp_container"></div><script>const tenecs_external_storage_js = (function () {
+const module = { exports: {} };
+const exports = module.exports;
+exports.read = (key) => localStorage.getItem(key)
+return module.exports;
+})();
+</script><script>`
+	assert.Contains(t, generated, expectedExternalScript)
+	assert.Contains(t, generated, `return tenecs_external_storage_js["read"](arg0)`)
+}
@@ -21,3 +21,35 @@ func runtimeRefCreator() string {
   }
 })`
 }
+
+// runtimeFakeClock starts at the epoch and only moves when set, advanced or slept on.
+func runtimeFakeClock() string {
+	return `(() => {
+  let now = 0
+  return ({
+    "$type": "FakeClock",
+    "advance": (duration) => {
+      now += duration.milliseconds
+      return null
+    },
+    "set": (instant) => {
+      now = instant.epochMilliseconds
+      return null
+    },
+    "time": ({
+      "$type": "Time",
+      "now": () => {
+        return ({ "$type": "Instant", "epochMilliseconds": now })
+      },
+      "sleep": (duration) => {
+        now += duration.milliseconds
+        return null
+      },
+      "today": () => {
+        const date = new Date(now)
+        return ({ "$type": "Date", "year": date.getUTCFullYear(), "month": date.getUTCMonth() + 1, "day": date.getUTCDate() })
+      }
+    })
+  })
+})()`
+}
@@ -0,0 +1,143 @@
+package codegen_js
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// SourceMap is a source map (revision 3) from the generated javascript back to the .10x files.
+type SourceMap struct {
+	Version  int      `json:"version"`
+	Sources  []string `json:"sources"`
+	Names    []string `json:"names"`
+	Mappings string   `json:"mappings"`
+}
+
+func GenerateProgramNonRunnableWithSourceMap(program *ast.Program) (string, SourceMap) {
+	return extractSourceMap(generateProgram(program))
+}
+
+func (sourceMap SourceMap) InlineComment() string {
+	bytes, err := json.Marshal(sourceMap)
+	if err != nil {
+		panic(err)
+	}
+	return "//# sourceMappingURL=data:application/json;charset=utf-8;base64," + base64.StdEncoding.EncodeToString(bytes)
+}
+
+// While generating, every expression is prefixed with a marker holding its code point.
+// The markers are removed afterward, recording where in the output each one was found.
+const sourceMapMarkerDelimiter = '\x00'
+
+func sourceMapMarker(codePoint ast.CodePoint) string {
+	if codePoint.FileName == "" {
+		return ""
+	}
+	return fmt.Sprintf("%c%d:%d:%s%c", sourceMapMarkerDelimiter, codePoint.Line, codePoint.Column, codePoint.FileName, sourceMapMarkerDelimiter)
+}
+
+func extractSourceMap(markedCode string) (string, SourceMap) {
+	sourceMap := SourceMap{
+		Version:  3,
+		Sources:  []string{},
+		Names:    []string{},
+		Mappings: "",
+	}
+	sourceIndexes := map[string]int{}
+
+	code := strings.Builder{}
+	mappings := strings.Builder{}
+
+	generatedColumn := 0
+	previousGeneratedColumn := 0
+	previousSourceIndex := 0
+	previousSourceLine := 0
+	previousSourceColumn := 0
+	lineHasSegment := false
+	lastSegmentColumn := -1
+
+	remaining := markedCode
+	for len(remaining) > 0 {
+		markerStart := strings.IndexRune(remaining, sourceMapMarkerDelimiter)
+		text := remaining
+		if markerStart >= 0 {
+			text = remaining[:markerStart]
+		}
+		for _, r := range text {
+			code.WriteRune(r)
+			if r == '\n' {
+				mappings.WriteRune(';')
+				generatedColumn = 0
+				previousGeneratedColumn = 0
+				lineHasSegment = false
+				lastSegmentColumn = -1
+			} else {
+				generatedColumn += len(utf16.Encode([]rune{r}))
+			}
+		}
+		if markerStart < 0 {
+			break
+		}
+		remaining = remaining[markerStart+1:]
+		markerEnd := strings.IndexRune(remaining, sourceMapMarkerDelimiter)
+		marker := remaining[:markerEnd]
+		remaining = remaining[markerEnd+1:]
+
+		if generatedColumn == lastSegmentColumn {
+			continue
+		}
+		split := strings.SplitN(marker, ":", 3)
+		line, _ := strconv.Atoi(split[0])
+		column, _ := strconv.Atoi(split[1])
+		file := split[2]
+		sourceIndex, ok := sourceIndexes[file]
+		if !ok {
+			sourceIndex = len(sourceMap.Sources)
+			sourceIndexes[file] = sourceIndex
+			sourceMap.Sources = append(sourceMap.Sources, file)
+		}
+
+		if lineHasSegment {
+			mappings.WriteRune(',')
+		}
+		mappings.WriteString(base64Vlq(generatedColumn - previousGeneratedColumn))
+		mappings.WriteString(base64Vlq(sourceIndex - previousSourceIndex))
+		mappings.WriteString(base64Vlq(line - 1 - previousSourceLine))
+		mappings.WriteString(base64Vlq(column - 1 - previousSourceColumn))
+		previousGeneratedColumn = generatedColumn
+		previousSourceIndex = sourceIndex
+		previousSourceLine = line - 1
+		previousSourceColumn = column - 1
+		lineHasSegment = true
+		lastSegmentColumn = generatedColumn
+	}
+
+	sourceMap.Mappings = mappings.String()
+	return code.String(), sourceMap
+}
+
+const base64Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+func base64Vlq(value int) string {
+	vlq := value << 1
+	if value < 0 {
+		vlq = (-value << 1) | 1
+	}
+	result := ""
+	for {
+		digit := vlq & 31
+		vlq >>= 5
+		if vlq > 0 {
+			digit |= 32
+		}
+		result += string(base64Alphabet[digit])
+		if vlq == 0 {
+			return result
+		}
+	}
+}
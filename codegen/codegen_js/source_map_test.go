@@ -0,0 +1,41 @@
+package codegen_js_test
+
+import (
+	"github.com/alecthomas/assert/v2"
+	"github.com/xplosunn/tenecs/codegen/codegen_js"
+	"github.com/xplosunn/tenecs/desugar"
+	"github.com/xplosunn/tenecs/parser"
+	"github.com/xplosunn/tenecs/typer"
+	"testing"
+)
+
+func TestGenerateProgramNonRunnableWithSourceMap(t *testing.T) {
+	program := `package main
+
+import tenecs.go.Runtime
+import tenecs.go.Main
+
+app := Main(
+  main = (runtime: Runtime) => {
+    runtime.console.log("Hello world")
+  }
+)`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	generated, sourceMap := codegen_js.GenerateProgramNonRunnableWithSourceMap(typed)
+	assert.Equal(t, codegen_js.GenerateProgramNonRunnable(typed), generated)
+	assert.Equal(t, codegen_js.SourceMap{
+		Version:  3,
+		Sources:  []string{"file.10x"},
+		Names:    []string{},
+		Mappings: "gBAKO,gBACE;OACL,0BAAoB;;;;;;;;;;;;;;;;;;;;;;;;;",
+	}, sourceMap)
+}
@@ -3,6 +3,8 @@ package standard_library
 import (
 	"fmt"
 	"github.com/xplosunn/tenecs/typer/standard_library"
+	"github.com/xplosunn/tenecs/typer/types"
+	"golang.org/x/exp/slices"
 )
 
 //go:generate go run ../standard_library_generate/main.go
@@ -56,11 +58,31 @@ func structFunction(structWithFields *standard_library.StructWithFields) Functio
 	bodyStr := "return ({\n"
 	bodyStr += fmt.Sprintf(`  "$type": "%s",`, structWithFields.Struct.Name) + "\n"
 	for _, fieldName := range structWithFields.FieldNamesSorted {
-		bodyStr += fmt.Sprintf(`  "%s": %s,`, fieldName, fieldName) + "\n"
+		value := fieldName
+		if !slices.Contains(structWithFields.ConstructorFieldNames, fieldName) {
+			value = emptyValue(structWithFields.Fields[fieldName])
+		}
+		bodyStr += fmt.Sprintf(`  "%s": %s,`, fieldName, value) + "\n"
 	}
 	bodyStr += "})"
 	return function(
-		params(structWithFields.FieldNamesSorted...),
+		params(structWithFields.ConstructorFieldNames...),
 		body(bodyStr),
 	)
 }
+
+// emptyValue is what a struct field the constructor doesn't take starts out as.
+func emptyValue(varType types.VariableType) string {
+	_, caseList, _, _, caseOr := varType.VariableTypeCases()
+	if caseList != nil {
+		return "[]"
+	}
+	if caseOr != nil {
+		for _, element := range caseOr.Elements {
+			if types.VariableTypeEq(element, types.Void()) {
+				return "null"
+			}
+		}
+	}
+	panic("no empty value for " + types.PrintableName(varType))
+}
@@ -0,0 +1,162 @@
+// ##################################################################
+// # The signatures of this file are generated via code-generation. #
+// # Check gen.go                                                   #
+// ##################################################################
+package standard_library
+
+import "github.com/xplosunn/tenecs/typer/standard_library"
+
+const bigIntFromHelper = `const bigIntFrom = (value) => ({
+  "$type": "BigInt",
+  "digits": value.toString()
+})
+`
+
+const bigIntDivisionByZeroHelper = `const divisionByZero = ({
+  "$type": "Error",
+  "message": "Division by zero",
+  "cause": null,
+  "code": null,
+  "details": []
+})
+`
+
+func tenecs_bigint_BigInt() Function {
+	return structFunction(standard_library.Tenecs_bigint_BigInt)
+}
+func tenecs_bigint_fromInt() Function {
+	return function(
+		params("value"),
+		body(bigIntFromHelper+`return bigIntFrom(BigInt(value))`),
+	)
+}
+func tenecs_bigint_fromString() Function {
+	return function(
+		params("str"),
+		body(bigIntFromHelper+`if (/^[+-]?[0-9]+$/.test(str)) {
+  return bigIntFrom(BigInt(str))
+}
+return ({
+  "$type": "Error",
+  "message": JSON.stringify(str) + " is not a BigInt",
+  "cause": null,
+  "code": null,
+  "details": []
+})`),
+	)
+}
+func tenecs_bigint_toInt() Function {
+	return function(
+		params("value"),
+		body(intFromBigIntHelper+`const result = BigInt(value.digits)
+if (BigInt.asIntN(64, result) !== result) {
+  return ({
+    "$type": "Error",
+    "message": value.digits + " is out of range for an Int",
+    "cause": null,
+    "code": null,
+    "details": []
+  })
+}
+return intFromBigInt(result)`),
+	)
+}
+func tenecs_bigint_toString() Function {
+	return function(
+		params("value"),
+		body(`return value.digits`),
+	)
+}
+func tenecs_bigint_plus() Function {
+	return function(
+		params("a", "b"),
+		body(bigIntFromHelper+`return bigIntFrom(BigInt(a.digits) + BigInt(b.digits))`),
+	)
+}
+func tenecs_bigint_minus() Function {
+	return function(
+		params("a", "b"),
+		body(bigIntFromHelper+`return bigIntFrom(BigInt(a.digits) - BigInt(b.digits))`),
+	)
+}
+func tenecs_bigint_times() Function {
+	return function(
+		params("a", "b"),
+		body(bigIntFromHelper+`return bigIntFrom(BigInt(a.digits) * BigInt(b.digits))`),
+	)
+}
+func tenecs_bigint_div() Function {
+	return function(
+		params("a", "b"),
+		body(bigIntFromHelper+bigIntDivisionByZeroHelper+`if (BigInt(b.digits) === 0n) {
+  return divisionByZero
+}
+return bigIntFrom(BigInt(a.digits) / BigInt(b.digits))`),
+	)
+}
+func tenecs_bigint_mod() Function {
+	return function(
+		params("a", "b"),
+		body(bigIntFromHelper+bigIntDivisionByZeroHelper+`if (BigInt(b.digits) === 0n) {
+  return divisionByZero
+}
+return bigIntFrom(BigInt(a.digits) % BigInt(b.digits))`),
+	)
+}
+func tenecs_bigint_pow() Function {
+	return function(
+		params("base", "exponent"),
+		body(bigIntFromHelper+`if (exponent < 0) {
+  return ({
+    "$type": "Error",
+    "message": "Exponent must not be negative",
+    "cause": null,
+    "code": null,
+    "details": []
+  })
+}
+return bigIntFrom(BigInt(base.digits) ** BigInt(exponent))`),
+	)
+}
+func tenecs_bigint_negate() Function {
+	return function(
+		params("a"),
+		body(bigIntFromHelper+`return bigIntFrom(-BigInt(a.digits))`),
+	)
+}
+func tenecs_bigint_abs() Function {
+	return function(
+		params("a"),
+		body(bigIntFromHelper+`const value = BigInt(a.digits)
+return bigIntFrom(value < 0n ? -value : value)`),
+	)
+}
+func tenecs_bigint_greaterThan() Function {
+	return function(
+		params("a", "b"),
+		body(`return BigInt(a.digits) > BigInt(b.digits)`),
+	)
+}
+func tenecs_bigint_lessThan() Function {
+	return function(
+		params("a", "b"),
+		body(`return BigInt(a.digits) < BigInt(b.digits)`),
+	)
+}
+func tenecs_bigint_comparator() Function {
+	return function(
+		body(`return ({
+  "$type": "Comparator",
+  "compare": (first, second) => {
+    const firstValue = BigInt(first.digits)
+    const secondValue = BigInt(second.digits)
+    if (firstValue < secondValue) {
+      return ({ "$type": "Less" })
+    } else if (firstValue > secondValue) {
+      return ({ "$type": "Greater" })
+    }
+    return ({ "$type": "Equal" })
+  }
+})`),
+	)
+}
@@ -0,0 +1,51 @@
+// ##################################################################
+// # The signatures of this file are generated via code-generation. #
+// # Check gen.go                                                   #
+// ##################################################################
+package standard_library
+
+func tenecs_char_codePoint() Function {
+	return function(
+		params("char"),
+		body(`return char.codePoint`),
+	)
+}
+func tenecs_char_fromCodePoint() Function {
+	return function(
+		params("codePoint"),
+		body(`if (codePoint < 0 || codePoint > 0x10FFFF || (codePoint >= 0xD800 && codePoint <= 0xDFFF)) {
+  return ({
+    "$type": "Error",
+    "message": codePoint + " is not a unicode code point",
+    "cause": null,
+    "code": null,
+    "details": []
+  })
+}
+return ({ "$type": "Char", "codePoint": codePoint })`),
+	)
+}
+func tenecs_char_isDigit() Function {
+	return function(
+		params("char"),
+		body(`return char.codePoint >= 48 && char.codePoint <= 57`),
+	)
+}
+func tenecs_char_isLetter() Function {
+	return function(
+		params("char"),
+		body(`return /\p{L}/u.test(String.fromCodePoint(char.codePoint))`),
+	)
+}
+func tenecs_char_isWhitespace() Function {
+	return function(
+		params("char"),
+		body(`return /\p{White_Space}/u.test(String.fromCodePoint(char.codePoint))`),
+	)
+}
+func tenecs_char_toString() Function {
+	return function(
+		params("char"),
+		body(`return String.fromCodePoint(char.codePoint)`),
+	)
+}
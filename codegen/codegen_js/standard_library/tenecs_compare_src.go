@@ -4,11 +4,137 @@
 // ##################################################################
 package standard_library
 
+import "github.com/xplosunn/tenecs/typer/standard_library"
+
+func tenecs_compare_Comparator() Function {
+	return structFunction(standard_library.Tenecs_compare_Comparator)
+}
+func tenecs_compare_Equal() Function {
+	return structFunction(standard_library.Tenecs_compare_Equal)
+}
+func tenecs_compare_Greater() Function {
+	return structFunction(standard_library.Tenecs_compare_Greater)
+}
+func tenecs_compare_Less() Function {
+	return structFunction(standard_library.Tenecs_compare_Less)
+}
+
 func tenecs_compare_eq() Function {
 	return function(
 		params("first", "second"),
-		body(`
-function areDeeplyEqual(obj1, obj2) {
+		body(areDeeplyEqualHelper+`return areDeeplyEqual(first, second);
+`),
+	)
+}
+func tenecs_compare_by() Function {
+	return function(
+		params("field", "comparator"),
+		body(`return ({
+  "$type": "Comparator",
+  "compare": (first, second) => comparator.compare(field(first), field(second))
+})`),
+	)
+}
+func tenecs_compare_dateComparator() Function {
+	return function(
+		body(`return ({
+  "$type": "Comparator",
+  "compare": (first, second) => {
+    for (const [a, b] of [[first.year, second.year], [first.month, second.month], [first.day, second.day]]) {
+      if (a < b) {
+        return ({ "$type": "Less" })
+      } else if (a > b) {
+        return ({ "$type": "Greater" })
+      }
+    }
+    return ({ "$type": "Equal" })
+  }
+})`),
+	)
+}
+func tenecs_compare_floatComparator() Function {
+	return function(
+		body(`return ({
+  "$type": "Comparator",
+  "compare": (first, second) => {
+    if (first < second) {
+      return ({ "$type": "Less" })
+    } else if (first > second) {
+      return ({ "$type": "Greater" })
+    }
+    return ({ "$type": "Equal" })
+  }
+})`),
+	)
+}
+func tenecs_compare_intComparator() Function {
+	return function(
+		body(`return ({
+  "$type": "Comparator",
+  "compare": (first, second) => {
+    if (first < second) {
+      return ({ "$type": "Less" })
+    } else if (first > second) {
+      return ({ "$type": "Greater" })
+    }
+    return ({ "$type": "Equal" })
+  }
+})`),
+	)
+}
+func tenecs_compare_reverse() Function {
+	return function(
+		params("comparator"),
+		body(`return ({
+  "$type": "Comparator",
+  "compare": (first, second) => comparator.compare(second, first)
+})`),
+	)
+}
+func tenecs_compare_stringComparator() Function {
+	return function(
+		body(`return ({
+  "$type": "Comparator",
+  "compare": (first, second) => {
+    // compared by code point rather than by UTF-16 code unit, to order like Go does
+    const a = Array.from(first)
+    const b = Array.from(second)
+    for (let i = 0; i < a.length && i < b.length; i++) {
+      const diff = a[i].codePointAt(0) - b[i].codePointAt(0)
+      if (diff < 0) {
+        return ({ "$type": "Less" })
+      } else if (diff > 0) {
+        return ({ "$type": "Greater" })
+      }
+    }
+    if (a.length < b.length) {
+      return ({ "$type": "Less" })
+    } else if (a.length > b.length) {
+      return ({ "$type": "Greater" })
+    }
+    return ({ "$type": "Equal" })
+  }
+})`),
+	)
+}
+func tenecs_compare_thenBy() Function {
+	return function(
+		params("first", "second"),
+		body(`return ({
+  "$type": "Comparator",
+  "compare": (a, b) => {
+    const ordering = first.compare(a, b)
+    if (ordering["$type"] === "Equal") {
+      return second.compare(a, b)
+    }
+    return ordering
+  }
+})`),
+	)
+}
+
+// areDeeplyEqualHelper defines areDeeplyEqual, which is how eq compares values.
+const areDeeplyEqualHelper = `function areDeeplyEqual(obj1, obj2) {
   if (typeof obj1 === 'function') return false;
   if (obj1 === obj2) return true;
 
@@ -42,7 +168,4 @@ function areDeeplyEqual(obj1, obj2) {
 
   return false;
 }
-return areDeeplyEqual(first, second);
-`),
-	)
-}
+`
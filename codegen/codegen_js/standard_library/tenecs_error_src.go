@@ -9,3 +9,82 @@ import "github.com/xplosunn/tenecs/typer/standard_library"
 func tenecs_error_Error() Function {
 	return structFunction(standard_library.Tenecs_error_Error)
 }
+func tenecs_error_ErrorDetail() Function {
+	return structFunction(standard_library.Tenecs_error_ErrorDetail)
+}
+func tenecs_error_causes() Function {
+	return function(
+		params("error"),
+		body(`let result = []
+let current = error
+while (current != null) {
+  result.push(current)
+  current = current.cause
+}
+return result`),
+	)
+}
+func tenecs_error_render() Function {
+	return function(
+		params("error"),
+		body(`let lines = []
+let current = error
+while (current != null) {
+  let line = current.message
+  if (current.code != null) {
+    line += " [" + current.code + "]"
+  }
+  if (current.details.length > 0) {
+    line += " (" + current.details.map((d) => d.key + "=" + d.value).join(", ") + ")"
+  }
+  if (lines.length > 0) {
+    line = "caused by: " + line
+  }
+  lines.push(line)
+  current = current.cause
+}
+return lines.join("\n")`),
+	)
+}
+func tenecs_error_withCode() Function {
+	return function(
+		params("error", "code"),
+		body(`return ({ ...error, "code": code })`),
+	)
+}
+func tenecs_error_withContext() Function {
+	return function(
+		params("result", "message"),
+		body(`if (result != null && result["$type"] === "Error") {
+  return ({
+    "$type": "Error",
+    "message": message,
+    "cause": result,
+    "code": null,
+    "details": []
+  })
+}
+return result`),
+	)
+}
+func tenecs_error_withDetail() Function {
+	return function(
+		params("error", "key", "value"),
+		body(`return ({
+  ...error,
+  "details": error.details.concat([{ "$type": "ErrorDetail", "key": key, "value": value }])
+})`),
+	)
+}
+func tenecs_error_wrap() Function {
+	return function(
+		params("cause", "message"),
+		body(`return ({
+  "$type": "Error",
+  "message": message,
+  "cause": cause,
+  "code": null,
+  "details": []
+})`),
+	)
+}
@@ -0,0 +1,39 @@
+// ##################################################################
+// # The signatures of this file are generated via code-generation. #
+// # Check gen.go                                                   #
+// ##################################################################
+package standard_library
+
+import "github.com/xplosunn/tenecs/typer/standard_library"
+
+func tenecs_http_header() Function {
+	return function(
+		params("headers", "name"),
+		body(`for (const header of headers) {
+  if (header.name.toLowerCase() === name.toLowerCase()) {
+    return header.value
+  }
+}
+return null`),
+	)
+}
+func tenecs_http_ok() Function {
+	return function(
+		params("body"),
+		body(`return ({
+  "$type": "Response",
+  "status": 200,
+  "headers": [],
+  "body": body
+})`),
+	)
+}
+func tenecs_http_Header() Function {
+	return structFunction(standard_library.Tenecs_http_Header)
+}
+func tenecs_http_Request() Function {
+	return structFunction(standard_library.Tenecs_http_Request)
+}
+func tenecs_http_Response() Function {
+	return structFunction(standard_library.Tenecs_http_Response)
+}
@@ -4,31 +4,82 @@
 // ##################################################################
 package standard_library
 
+// intFromBigIntHelper defines intFromBigInt, which wraps a BigInt around to 64 bits like Go does.
+// An Int is a Number while it's a safe integer and a BigInt otherwise, so every Int has a single representation.
+const intFromBigIntHelper = `const intFromBigInt = (value) => {
+  const wrapped = BigInt.asIntN(64, value)
+  if (wrapped >= BigInt(Number.MIN_SAFE_INTEGER) && wrapped <= BigInt(Number.MAX_SAFE_INTEGER)) {
+    return Number(wrapped)
+  }
+  return wrapped
+}
+`
+
+// intAsNumberHelper defines intAsNumber, for the Ints that are used as a count or an index. It clamps the ones that
+// are a BigInt to the safe integers, which clamps them the way Go does too, since no String or List gets that long.
+const intAsNumberHelper = `const intAsNumber = (value) => {
+  if (typeof value !== "bigint") {
+    return value
+  } else if (value > BigInt(Number.MAX_SAFE_INTEGER)) {
+    return Number.MAX_SAFE_INTEGER
+  } else if (value < BigInt(Number.MIN_SAFE_INTEGER)) {
+    return Number.MIN_SAFE_INTEGER
+  }
+  return Number(value)
+}
+`
+
+// intCheckedHelper defines intChecked, which is intFromBigInt without the wrapping around.
+const intCheckedHelper = intFromBigIntHelper + `const intChecked = (value) => {
+  if (BigInt.asIntN(64, value) !== value) {
+    return ({
+      "$type": "Error",
+      "message": "Int overflow",
+      "cause": null,
+      "code": null,
+      "details": []
+    })
+  }
+  return intFromBigInt(value)
+}
+`
+
 func tenecs_int_minus() Function {
 	return function(
 		params("a", "b"),
-		body(`return a - b`),
+		body(intFromBigIntHelper+`if (typeof a === "number" && typeof b === "number" && Number.isSafeInteger(a - b)) {
+  return a - b
+}
+return intFromBigInt(BigInt(a) - BigInt(b))`),
 	)
 }
 func tenecs_int_plus() Function {
 	return function(
 		params("a", "b"),
-		body(`return a + b`),
+		body(intFromBigIntHelper+`if (typeof a === "number" && typeof b === "number" && Number.isSafeInteger(a + b)) {
+  return a + b
+}
+return intFromBigInt(BigInt(a) + BigInt(b))`),
 	)
 }
 func tenecs_int_times() Function {
 	return function(
 		params("a", "b"),
-		body(`return a * b`),
+		body(intFromBigIntHelper+`if (typeof a === "number" && typeof b === "number" && Number.isSafeInteger(a * b)) {
+  return a * b
+}
+return intFromBigInt(BigInt(a) * BigInt(b))`),
 	)
 }
 func tenecs_int_ponyDiv() Function {
 	return function(
 		params("a", "b"),
-		body(`if (b == 0) {
+		body(intFromBigIntHelper+`if (b == 0) {
   return 0
-} else {
+} else if (typeof a === "number" && typeof b === "number" && Math.abs(a) < Number.MAX_SAFE_INTEGER && Math.abs(b) < Number.MAX_SAFE_INTEGER) {
   return Math.trunc(a / b)
+} else {
+  return intFromBigInt(BigInt(a) / BigInt(b))
 }
 `),
 	)
@@ -36,13 +87,18 @@ func tenecs_int_ponyDiv() Function {
 func tenecs_int_div() Function {
 	return function(
 		params("a", "b"),
-		body(`if (b == 0) {
+		body(intFromBigIntHelper+`if (b == 0) {
   return ({
     "$type": "Error",
-    "message": "Division by zero"
+    "message": "Division by zero",
+    "cause": null,
+    "code": null,
+    "details": []
   })
-} else {
+} else if (typeof a === "number" && typeof b === "number" && Math.abs(a) < Number.MAX_SAFE_INTEGER && Math.abs(b) < Number.MAX_SAFE_INTEGER) {
   return Math.trunc(a / b)
+} else {
+  return intFromBigInt(BigInt(a) / BigInt(b))
 }
 `),
 	)
@@ -50,13 +106,18 @@ func tenecs_int_div() Function {
 func tenecs_int_mod() Function {
 	return function(
 		params("a", "b"),
-		body(`if (b == 0) {
+		body(intFromBigIntHelper+`if (b == 0) {
   return ({
     "$type": "Error",
-    "message": "Division by zero"
+    "message": "Division by zero",
+    "cause": null,
+    "code": null,
+    "details": []
   })
-} else {
+} else if (typeof a === "number" && typeof b === "number") {
   return a % b
+} else {
+  return intFromBigInt(BigInt(a) % BigInt(b))
 }
 `),
 	)
@@ -64,10 +125,12 @@ func tenecs_int_mod() Function {
 func tenecs_int_ponyMod() Function {
 	return function(
 		params("a", "b"),
-		body(`if (b == 0) {
+		body(intFromBigIntHelper+`if (b == 0) {
   return 0
-} else {
+} else if (typeof a === "number" && typeof b === "number") {
   return a % b
+} else {
+  return intFromBigInt(BigInt(a) % BigInt(b))
 }
 `),
 	)
@@ -87,12 +150,48 @@ func tenecs_int_lessThan() Function {
 func tenecs_int_negate() Function {
 	return function(
 		params("a"),
-		body(`return -a`),
+		body(intFromBigIntHelper+`if (typeof a === "number") {
+  return -a
+}
+return intFromBigInt(-a)`),
 	)
 }
 func tenecs_int_abs() Function {
 	return function(
 		params("a"),
-		body(`return Math.abs(a)`),
+		body(intFromBigIntHelper+`if (typeof a === "number") {
+  return Math.abs(a)
+}
+return intFromBigInt(a < 0n ? -a : a)`),
+	)
+}
+func tenecs_int_checkedPlus() Function {
+	return function(
+		params("a", "b"),
+		body(intCheckedHelper+`return intChecked(BigInt(a) + BigInt(b))`),
+	)
+}
+func tenecs_int_checkedMinus() Function {
+	return function(
+		params("a", "b"),
+		body(intCheckedHelper+`return intChecked(BigInt(a) - BigInt(b))`),
+	)
+}
+func tenecs_int_checkedTimes() Function {
+	return function(
+		params("a", "b"),
+		body(intCheckedHelper+`return intChecked(BigInt(a) * BigInt(b))`),
+	)
+}
+func tenecs_int_checkedNegate() Function {
+	return function(
+		params("a"),
+		body(intCheckedHelper+`return intChecked(-BigInt(a))`),
+	)
+}
+func tenecs_int_checkedAbs() Function {
+	return function(
+		params("a"),
+		body(intCheckedHelper+`return intChecked(a < 0 ? -BigInt(a) : BigInt(a))`),
 	)
 }
@@ -6,9 +6,230 @@ package standard_library
 
 import "github.com/xplosunn/tenecs/typer/standard_library"
 
+// The helpers below are pasted into the converters that use them. Each error of a converter is kept in the details of
+// its Error, as a json.path followed by the json.expected, json.actual and json.message of it, and the message of the
+// Error has one line per error rendered from them. Converters made of other converters read the errors of those back
+// from the details to put them at their own path.
+// A field missing from an object reaches the converters of the standard library as an undefined input, which the ones
+// made with the JsonConverter constructor see as an empty String instead.
+
+const jsonFailureHelper = `const jsonFailure = (decodeErrors) => ({
+  "$type": "Error",
+  "message": decodeErrors.map((decodeError) => decodeError.path + ": " + decodeError.message).join("\n"),
+  "cause": null,
+  "code": null,
+  "details": decodeErrors.flatMap((decodeError) => [
+    { "$type": "ErrorDetail", "key": "json.path", "value": decodeError.path },
+    { "$type": "ErrorDetail", "key": "json.expected", "value": decodeError.expected },
+    { "$type": "ErrorDetail", "key": "json.actual", "value": decodeError.actual },
+    { "$type": "ErrorDetail", "key": "json.message", "value": decodeError.message },
+  ])
+})
+const isJsonError = (result) => result !== null && typeof result === "object" && result["$type"] === "Error"
+`
+
+const jsonExpectedHelper = jsonFailureHelper + `const jsonKind = (input) => {
+  if (input === undefined) {
+    return "missing"
+  }
+  let parsed = undefined
+  try {
+    parsed = JSON.parse(input)
+  } catch (e) {
+    return "invalid json"
+  }
+  if (parsed === null) {
+    return "null"
+  } else if (Array.isArray(parsed)) {
+    return "array"
+  }
+  return typeof parsed
+}
+const jsonExpected = (expected, input) => {
+  const actual = jsonKind(input)
+  return jsonFailure([{
+    "$type": "JsonDecodeError",
+    "path": "$",
+    "expected": expected,
+    "actual": actual,
+    "message": "expected " + expected + ", got " + actual
+  }])
+}
+`
+
+// jsonDecodeErrorsHelper takes an Error without json details, like the ones of custom converters, as a single error at $
+const jsonDecodeErrorsHelper = `const jsonDecodeErrors = (error) => {
+  const decodeErrors = []
+  for (const detail of error.details) {
+    if (detail.key === "json.path") {
+      decodeErrors.push({ "$type": "JsonDecodeError", "path": detail.value, "expected": "", "actual": "", "message": "" })
+    } else if (decodeErrors.length > 0 && ["json.expected", "json.actual", "json.message"].includes(detail.key)) {
+      decodeErrors[decodeErrors.length - 1][detail.key.substring("json.".length)] = detail.value
+    }
+  }
+  if (decodeErrors.length === 0) {
+    decodeErrors.push({ "$type": "JsonDecodeError", "path": "$", "expected": "", "actual": "", "message": error.message })
+  }
+  return decodeErrors
+}
+`
+
+// jsonOrHelper merges the errors of options that were all expecting something else at $,
+// and otherwise keeps the first one that got further into the json
+const jsonOrHelper = `const jsonOrFailure = (errors) => {
+  const expected = []
+  let actual = ""
+  for (const error of errors) {
+    const decodeErrors = jsonDecodeErrors(error)
+    if (decodeErrors.length !== 1 || decodeErrors[0].path !== "$" || decodeErrors[0].expected === "") {
+      return error
+    }
+    expected.push(decodeErrors[0].expected)
+    actual = decodeErrors[0].actual
+  }
+  return jsonFailure([{
+    "$type": "JsonDecodeError",
+    "path": "$",
+    "expected": expected.join(" | "),
+    "actual": actual,
+    "message": "expected " + expected.join(" | ") + ", got " + actual
+  }])
+}
+`
+
+const jsonAtHelper = `const jsonAt = (segment, error) => {
+  return jsonDecodeErrors(error).map((decodeError) => ({
+    ...decodeError,
+    "path": "$" + segment + (decodeError.path.startsWith("$") ? decodeError.path.substring(1) : decodeError.path)
+  }))
+}
+`
+
+// jsonIntHelper reads an integer from its digits, so that it's exact over the whole Int range,
+// and otherwise takes a number that's a safe integer.
+const jsonIntHelper = intFromBigIntHelper + `const jsonInt = (input) => {
+  const trimmed = input === undefined ? "" : input.trim()
+  if (/^-?(0|[1-9][0-9]*)$/.test(trimmed)) {
+    const parsed = BigInt(trimmed)
+    if (BigInt.asIntN(64, parsed) === parsed) {
+      return intFromBigInt(parsed)
+    }
+  }
+  try {
+    let parsed = JSON.parse(input)
+    if (typeof parsed == "number" && Number.isSafeInteger(parsed)) {
+      return parsed
+    }
+  } catch (e) {}
+  return undefined
+}
+`
+
+// jsonRawHelper splits a json array or object into the json of its elements or entries, as they're in the input,
+// since parsing them with JSON.parse would round the numbers that aren't safe integers.
+// Both give undefined when the input isn't one.
+const jsonRawHelper = `const jsonStringEnd = (input, start) => {
+  let i = start + 1
+  while (input[i] !== '"') {
+    i += input[i] === "\\" ? 2 : 1
+  }
+  return i + 1
+}
+const jsonValueEnd = (input, start) => {
+  let depth = 0
+  let i = start
+  while (i < input.length) {
+    const c = input[i]
+    if (c === '"') {
+      i = jsonStringEnd(input, i)
+      continue
+    } else if (c === "[" || c === "{") {
+      depth++
+    } else if (c === "]" || c === "}") {
+      if (depth === 0) {
+        return i
+      }
+      depth--
+    } else if (c === "," && depth === 0) {
+      return i
+    }
+    i++
+  }
+  return i
+}
+const jsonRawArray = (input) => {
+  try {
+    if (!Array.isArray(JSON.parse(input))) {
+      return undefined
+    }
+  } catch (e) {
+    return undefined
+  }
+  const trimmed = input.trim()
+  const result = []
+  let i = 1
+  while (true) {
+    const end = jsonValueEnd(trimmed, i)
+    const elem = trimmed.substring(i, end).trim()
+    if (elem === "") {
+      break
+    }
+    result.push(elem)
+    if (trimmed[end] === "]") {
+      break
+    }
+    i = end + 1
+  }
+  return result
+}
+const jsonRawObject = (input) => {
+  try {
+    const parsed = JSON.parse(input)
+    if (parsed === null || typeof parsed !== "object" || Array.isArray(parsed)) {
+      return undefined
+    }
+  } catch (e) {
+    return undefined
+  }
+  const trimmed = input.trim()
+  const result = new Map()
+  let i = 1
+  while (true) {
+    while (/\s/.test(trimmed[i])) {
+      i++
+    }
+    if (trimmed[i] === "}") {
+      break
+    }
+    const keyEnd = jsonStringEnd(trimmed, i)
+    const key = JSON.parse(trimmed.substring(i, keyEnd))
+    const valueStart = trimmed.indexOf(":", keyEnd) + 1
+    const end = jsonValueEnd(trimmed, valueStart)
+    result.set(key, trimmed.substring(valueStart, end).trim())
+    if (trimmed[end] === "}") {
+      break
+    }
+    i = end + 1
+  }
+  return result
+}
+`
+
+const jsonKeyHelper = `const jsonKey = (key) => {
+  return /^[A-Za-z_][A-Za-z0-9_]*$/.test(key) ? "." + key : "[" + JSON.stringify(key) + "]"
+}
+`
+
+func tenecs_json_decodeErrors() Function {
+	return function(
+		params("error"),
+		body(jsonDecodeErrorsHelper+`return jsonDecodeErrors(error)`),
+	)
+}
+
 func tenecs_json_jsonBoolean() Function {
 	return function(
-		body(`return ({
+		body(jsonExpectedHelper + `return ({
   "$type": "JsonConverter",
   "fromJson": (input) => {
     try {
@@ -17,10 +238,7 @@ func tenecs_json_jsonBoolean() Function {
         return parsed
       }
     } catch (e) {}
-    return ({
-      "$type": "Error",
-      "message": "Could not parse Boolean from " + input
-    })
+    return jsonExpected("Boolean", input)
   },
   "toJson": (input) => {
     return JSON.stringify(input)
@@ -29,21 +247,35 @@ func tenecs_json_jsonBoolean() Function {
 	)
 }
 
-func tenecs_json_jsonInt() Function {
+func tenecs_json_jsonFirstError() Function {
 	return function(
-		body(`return ({
+		params("of"),
+		body(jsonFailureHelper+jsonDecodeErrorsHelper+`return ({
+  "$type": "JsonConverter",
+  "fromJson": (input) => {
+    const result = of.fromJson(input)
+    if (isJsonError(result)) {
+      return jsonFailure(jsonDecodeErrors(result).slice(0, 1))
+    }
+    return result
+  },
+  "toJson": of.toJson,
+})`),
+	)
+}
+
+func tenecs_json_jsonFloat() Function {
+	return function(
+		body(jsonExpectedHelper + `return ({
   "$type": "JsonConverter",
   "fromJson": (input) => {
     try {
       let parsed = JSON.parse(input)
-      if (typeof parsed == "number" && parsed % 1 == 0) {
+      if (typeof parsed == "number") {
         return parsed
       }
     } catch (e) {}
-    return ({
-      "$type": "Error",
-      "message": "Could not parse Int from " + input
-    })
+    return jsonExpected("Float", input)
   },
   "toJson": (input) => {
     return JSON.stringify(input)
@@ -52,36 +284,88 @@ func tenecs_json_jsonInt() Function {
 	)
 }
 
+func tenecs_json_jsonInt() Function {
+	return function(
+		body(jsonExpectedHelper + jsonIntHelper + `return ({
+  "$type": "JsonConverter",
+  "fromJson": (input) => {
+    const result = jsonInt(input)
+    return result === undefined ? jsonExpected("Int", input) : result
+  },
+  "toJson": (input) => {
+    return input.toString()
+  },
+})`),
+	)
+}
+
+func tenecs_json_jsonNullable() Function {
+	return function(
+		params("of"),
+		body(jsonExpectedHelper+jsonDecodeErrorsHelper+jsonOrHelper+`return ({
+  "$type": "JsonConverter",
+  "fromJson": (input) => {
+    if (input !== undefined && input.trim() === "null") {
+      return null
+    }
+    const result = of.fromJson(input)
+    if (isJsonError(result)) {
+      return jsonOrFailure([result, jsonExpected("Void", input)])
+    }
+    return result
+  },
+  "toJson": (input) => {
+    if (input === null) {
+      return "null"
+    }
+    return of.toJson(input)
+  },
+})`),
+	)
+}
+
+func tenecs_json_jsonOptional() Function {
+	return function(
+		params("of", "whenMissing"),
+		body(`return ({
+  "$type": "JsonConverter",
+  "fromJson": (input) => {
+    if (input === undefined) {
+      return whenMissing
+    }
+    return of.fromJson(input)
+  },
+  "toJson": of.toJson,
+})`),
+	)
+}
+
 func tenecs_json_jsonOr() Function {
 	return function(
 		params("ConverterA", "ConverterB", "toJsonConverterPicker"),
-		body(`return ({
+		body(jsonExpectedHelper+jsonDecodeErrorsHelper+jsonOrHelper+`return ({
   "$type": "JsonConverter",
   "fromJson": (input) => {
-    let resultA = ConverterA["fromJson"](input)
-    if (resultA && resultA["$type"] && resultA["$type"] == "Error") {
-      let resultB = ConverterB["fromJson"](input)
-      if (resultB && resultB["$type"] && resultB["$type"] == "Error") {
-        return ({
-          "$type": "Error",
-          "message": "Could not parse from " + input
-        })
-      }
+    const resultA = ConverterA.fromJson(input)
+    if (!isJsonError(resultA)) {
+      return resultA
+    }
+    const resultB = ConverterB.fromJson(input)
+    if (!isJsonError(resultB)) {
       return resultB
     }
-    return resultA
+    return jsonOrFailure([resultA, resultB])
   },
   "toJson": (input) => {
-    let Converter = toJsonConverterPicker(input)
-    return Converter["toJson"](input)
-  }
+    return toJsonConverterPicker(input).toJson(input)
+  },
 })`),
 	)
 }
 
 func tenecs_json_jsonString() Function {
 	return function(
-		body(`return ({
+		body(jsonExpectedHelper + `return ({
   "$type": "JsonConverter",
   "fromJson": (input) => {
     try {
@@ -90,10 +374,7 @@ func tenecs_json_jsonString() Function {
         return parsed
       }
     } catch (e) {}
-    return ({
-      "$type": "Error",
-      "message": "Could not parse String from " + input
-    })
+    return jsonExpected("String", input)
   },
   "toJson": (input) => {
     return JSON.stringify(input)
@@ -102,29 +383,232 @@ func tenecs_json_jsonString() Function {
 	)
 }
 
+// tenecs_json_jsonConverter is invoked by the codegen with the name of the type it derives a converter for,
+// looking it up in the tenecsJsonDerivedTypes the codegen declares next to it.
+func tenecs_json_jsonConverter() Function {
+	return function(
+		params("typeName"),
+		body(jsonExpectedHelper+jsonDecodeErrorsHelper+jsonAtHelper+jsonKeyHelper+jsonIntHelper+jsonRawHelper+`const jsonKindOf = {
+  "String": "string",
+  "Int": "number",
+  "Float": "number",
+  "Boolean": "boolean",
+  "Void": "null",
+  "List": "array",
+  "Struct": "object",
+}
+const orTags = (derived) => derived.of.map((option) => tenecsJsonDerivedTypes[option]).filter((option) => option.kind === "Struct").map((option) => option.type)
+const fromJson = (typeName, input) => {
+  const derived = tenecsJsonDerivedTypes[typeName]
+  let parsed = undefined
+  try {
+    parsed = JSON.parse(input)
+  } catch (e) {}
+  if (derived.kind === "String") {
+    return typeof parsed === "string" ? parsed : jsonExpected("String", input)
+  } else if (derived.kind === "Int") {
+    const result = jsonInt(input)
+    return result === undefined ? jsonExpected("Int", input) : result
+  } else if (derived.kind === "Float") {
+    return typeof parsed === "number" ? parsed : jsonExpected("Float", input)
+  } else if (derived.kind === "Boolean") {
+    return typeof parsed === "boolean" ? parsed : jsonExpected("Boolean", input)
+  } else if (derived.kind === "Void") {
+    return parsed === null || input === undefined ? null : jsonExpected("Void", input)
+  } else if (derived.kind === "List") {
+    const elems = jsonRawArray(input)
+    if (elems === undefined) {
+      return jsonExpected("List", input)
+    }
+    const result = []
+    const failures = []
+    for (let i = 0; i < elems.length; i++) {
+      const elemResult = fromJson(derived.of[0], elems[i])
+      if (isJsonError(elemResult)) {
+        failures.push(...jsonAt("[" + i + "]", elemResult))
+        continue
+      }
+      result.push(elemResult)
+    }
+    if (failures.length > 0) {
+      return jsonFailure(failures)
+    }
+    return result
+  } else if (derived.kind === "Or") {
+    const kind = jsonKind(input) === "missing" ? "null" : jsonKind(input)
+    const tags = orTags(derived)
+    const expected = []
+    for (const option of derived.of) {
+      const optionDerived = tenecsJsonDerivedTypes[option]
+      expected.push(optionDerived.kind === "Struct" ? optionDerived.type : optionDerived.kind)
+      if (jsonKindOf[optionDerived.kind] !== kind || (kind === "object" && tags.length > 1)) {
+        continue
+      }
+      return fromJson(option, input)
+    }
+    if (kind !== "object" || tags.length < 2) {
+      return jsonExpected(expected.join(" | "), input)
+    }
+    const tag = parsed["$type"]
+    for (const option of derived.of) {
+      const optionDerived = tenecsJsonDerivedTypes[option]
+      if (optionDerived.kind === "Struct" && optionDerived.type === tag) {
+        return fromJson(option, input)
+      }
+    }
+    const tagInput = Object.prototype.hasOwnProperty.call(parsed, "$type") ? JSON.stringify(tag) : undefined
+    return jsonFailure(jsonAt(jsonKey("$type"), jsonExpected(tags.join(" | "), tagInput)))
+  } else if (derived.kind === "Struct") {
+    const entries = jsonRawObject(input)
+    if (entries === undefined) {
+      return jsonExpected("object", input)
+    }
+    const result = { "$type": derived.type }
+    const failures = []
+    for (let i = 0; i < derived.fields.length; i++) {
+      const field = derived.fields[i]
+      const fieldResult = fromJson(derived.of[i], entries.get(field))
+      if (isJsonError(fieldResult)) {
+        failures.push(...jsonAt(jsonKey(field), fieldResult))
+        continue
+      }
+      result[field] = fieldResult
+    }
+    if (failures.length > 0) {
+      return jsonFailure(failures)
+    }
+    return result
+  }
+  throw new Error("unexpected derived json kind " + derived.kind)
+}
+const matches = (typeName, value) => {
+  const derived = tenecsJsonDerivedTypes[typeName]
+  if (derived.kind === "String") {
+    return typeof value === "string"
+  } else if (derived.kind === "Int") {
+    return Number.isInteger(value) || typeof value === "bigint"
+  } else if (derived.kind === "Float") {
+    return typeof value === "number"
+  } else if (derived.kind === "Boolean") {
+    return typeof value === "boolean"
+  } else if (derived.kind === "Void") {
+    return value === null
+  } else if (derived.kind === "List") {
+    return Array.isArray(value) && value.every((elem) => matches(derived.of[0], elem))
+  } else if (derived.kind === "Or") {
+    return derived.of.some((option) => matches(option, value))
+  } else if (derived.kind === "Struct") {
+    return typeof value === "object" && value !== null && value["$type"] === derived.type && derived.fields.every((field, i) => matches(derived.of[i], value[field]))
+  }
+  throw new Error("unexpected derived json kind " + derived.kind)
+}
+const toJson = (typeName, value) => {
+  const derived = tenecsJsonDerivedTypes[typeName]
+  if (derived.kind === "Void") {
+    return "null"
+  } else if (derived.kind === "List") {
+    return "[" + value.map((elem) => toJson(derived.of[0], elem)).join(",") + "]"
+  } else if (derived.kind === "Or") {
+    for (const option of derived.of) {
+      if (!matches(option, value)) {
+        continue
+      }
+      const result = toJson(option, value)
+      const optionDerived = tenecsJsonDerivedTypes[option]
+      if (optionDerived.kind !== "Struct" || orTags(derived).length < 2) {
+        return result
+      }
+      const fieldsJson = result.substring(1, result.length - 1)
+      return "{\"$type\":" + JSON.stringify(optionDerived.type) + (fieldsJson === "" ? "" : "," + fieldsJson) + "}"
+    }
+  } else if (derived.kind === "Struct") {
+    const fields = derived.fields.map((field, i) => [field, derived.of[i]])
+    fields.sort((a, b) => a[0] < b[0] ? -1 : 1)
+    return "{" + fields.map(([field, fieldType]) => JSON.stringify(field) + ":" + toJson(fieldType, value[field])).join(",") + "}"
+  } else if (typeof value === "bigint") {
+    return value.toString()
+  } else {
+    return JSON.stringify(value)
+  }
+  throw new Error("can't convert to json as " + typeName)
+}
+return ({
+  "$type": "JsonConverter",
+  "fromJson": (input) => {
+    return fromJson(typeName, input)
+  },
+  "toJson": (value) => {
+    return toJson(typeName, value)
+  },
+})`),
+	)
+}
+
+func tenecs_json_jsonValue() Function {
+	return function(
+		body(jsonExpectedHelper + `const fromParsed = (value) => {
+  if (Array.isArray(value)) {
+    return ({
+      "$type": "JsonArray",
+      "values": value.map(fromParsed)
+    })
+  } else if (value !== null && typeof value === "object") {
+    const keys = Object.keys(value)
+    keys.sort((a, b) => a < b ? -1 : a > b ? 1 : 0)
+    return ({
+      "$type": "JsonObject",
+      "entries": keys.map((key) => ({
+        "$type": "JsonEntry",
+        "key": key,
+        "value": fromParsed(value[key])
+      }))
+    })
+  }
+  return value
+}
+const toJson = (value) => {
+  if (value !== null && value["$type"] === "JsonArray") {
+    return "[" + value.values.map(toJson).join(",") + "]"
+  } else if (value !== null && value["$type"] === "JsonObject") {
+    return "{" + value.entries.map((entry) => JSON.stringify(entry.key) + ":" + toJson(entry.value)).join(",") + "}"
+  }
+  return JSON.stringify(value)
+}
+return ({
+  "$type": "JsonConverter",
+  "fromJson": (input) => {
+    try {
+      return fromParsed(JSON.parse(input))
+    } catch (e) {}
+    return jsonExpected("JsonValue", input)
+  },
+  "toJson": toJson,
+})`),
+	)
+}
+
 func tenecs_json_jsonList() Function {
 	return function(
 		params("of"),
-		body(`return ({
+		body(jsonExpectedHelper+jsonDecodeErrorsHelper+jsonAtHelper+jsonRawHelper+`return ({
   "$type": "JsonConverter",
   "fromJson": (input) => {
+    const elems = jsonRawArray(input)
+    if (elems === undefined) {
+      return jsonExpected("List", input)
+    }
     const result = []
-    let fullParsed = JSON.parse(input)
-    if (!Array.isArray(fullParsed)) {
-      return ({
-        "$type": "Error",
-        "message": "Could not parse list from " + input
-      })
-    }
-    for (const elem of fullParsed) {
-      let field = of.fromJson(JSON.stringify(elem))
-      if (field && typeof field == "object" && field["$type"] == "Error") {
-        return ({
-          "$type": "Error",
-          "message": field.message
-        })
+    const failures = []
+    for (let i = 0; i < elems.length; i++) {
+      const elem = of.fromJson(elems[i])
+      if (isJsonError(elem)) {
+        failures.push(...jsonAt("[" + i + "]", elem))
+        continue
       }
-      result.push(field)
+      result.push(elem)
+    }
+    if (failures.length > 0) {
+      return jsonFailure(failures)
     }
     return result
   },
@@ -146,19 +630,16 @@ func tenecs_json_jsonList() Function {
 func tenecs_json_jsonObject0() Function {
 	return function(
 		params("f"),
-		body(`return ({
+		body(jsonExpectedHelper+`return ({
   "$type": "JsonConverter",
   "fromJson": (input) => {
     try {
       let parsed = JSON.parse(input)
-      if (typeof parsed == "object") {
+      if (parsed !== null && typeof parsed == "object" && !Array.isArray(parsed)) {
         return f()
       }
     } catch (e) {}
-    return ({
-      "$type": "Error",
-      "message": "Could not parse object from " + input
-    })
+    return jsonExpected("object", input)
   },
   "toJson": (input) => {
     return "{}"
@@ -170,7 +651,7 @@ func tenecs_json_jsonObject0() Function {
 func tenecs_json_jsonObject_X(x int) Function {
 	return function(
 		params("f"),	// the others are not listed
-		body(`
+		body(jsonExpectedHelper+jsonDecodeErrorsHelper+jsonAtHelper+jsonKeyHelper+jsonRawHelper+`
 let fieldParsers = []
 for (let i = 1; i < arguments.length; i++) {
   fieldParsers.push(arguments[i])
@@ -178,30 +659,22 @@ for (let i = 1; i < arguments.length; i++) {
 return ({
   "$type": "JsonConverter",
   "fromJson": (input) => {
-    let fullParsed = JSON.parse(input)
-    if (typeof fullParsed != "object") {
-      return ({
-        "$type": "Error",
-        "message": "Could not parse object from " + input
-      })
+    const entries = jsonRawObject(input)
+    if (entries === undefined) {
+      return jsonExpected("object", input)
     }
     let resultArguments = []
+    const failures = []
     for (const fieldParser of fieldParsers) {
-      if (fullParsed[fieldParser.name] == undefined) {
-        return ({
-          "$type": "Error",
-          "message": "Could not find object field \"" + fieldParser.name + "\" in " + input
-        })
-      } 
-      let field = fieldParser.Converter.fromJson(JSON.stringify(fullParsed[fieldParser.name]))
-      if (field && typeof field == "object" && field["$type"] == "Error") {
-        return ({
-          "$type": "Error",
-          "message": "Could not parse object field \"" + fieldParser.name + "\": " + field.message
-        })
+      let field = fieldParser.Converter.fromJson(entries.get(fieldParser.name))
+      if (isJsonError(field)) {
+        failures.push(...jsonAt(jsonKey(fieldParser.name), field))
       }
       resultArguments.push(field)
     }
+    if (failures.length > 0) {
+      return jsonFailure(failures)
+    }
     return f(...resultArguments)
   },
   "toJson": (input) => {
@@ -288,9 +761,28 @@ func tenecs_json_jsonObject16() Function {
 func tenecs_json_jsonObject20() Function {
 	return tenecs_json_jsonObject_X(20)
 }
+func tenecs_json_JsonArray() Function {
+	return structFunction(standard_library.Tenecs_json_JsonArray)
+}
+func tenecs_json_JsonEntry() Function {
+	return structFunction(standard_library.Tenecs_json_JsonEntry)
+}
+func tenecs_json_JsonDecodeError() Function {
+	return structFunction(standard_library.Tenecs_json_JsonDecodeError)
+}
 func tenecs_json_JsonField() Function {
 	return structFunction(standard_library.Tenecs_json_JsonField)
 }
+func tenecs_json_JsonObject() Function {
+	return structFunction(standard_library.Tenecs_json_JsonObject)
+}
 func tenecs_json_JsonConverter() Function {
-	return structFunction(standard_library.Tenecs_json_JsonConverter)
+	return function(
+		params("fromJson", "toJson"),
+		body(`return ({
+  "$type": "JsonConverter",
+  "fromJson": (input) => fromJson(input === undefined ? "" : input),
+  "toJson": toJson,
+})`),
+	)
 }
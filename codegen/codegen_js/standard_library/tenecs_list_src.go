@@ -34,7 +34,7 @@ func tenecs_list_mapNotNull() Function {
 func tenecs_list_repeat() Function {
 	return function(
 		params("elem", "times"),
-		body(`return Array(times).fill(elem)`),
+		body(intAsNumberHelper+`return Array(Math.max(intAsNumber(times), 0)).fill(elem)`),
 	)
 }
 func tenecs_list_length() Function {
@@ -126,7 +126,10 @@ if (index >= 0 && list.length > index) {
 }
 return ({
   "$type": "Error",
-  "message": "Out of bounds"
+  "message": "Out of bounds",
+  "cause": null,
+  "code": null,
+  "details": []
 });
 `),
 	)
@@ -142,7 +145,10 @@ if (index >= 0 && list.length > index) {
 }
 return ({
   "$type": "Error",
-  "message": "Out of bounds"
+  "message": "Out of bounds",
+  "cause": null,
+  "code": null,
+  "details": []
 });
 `),
 	)
@@ -159,3 +165,188 @@ func tenecs_list_flatten() Function {
 		body(`return list.flat();`),
 	)
 }
+func tenecs_list_max() Function {
+	return function(
+		params("list", "comparator"),
+		body(`let result = null
+list.forEach((elem, i) => {
+  if (i == 0 || comparator.compare(elem, result)["$type"] !== "Less") {
+    result = elem
+  }
+})
+return result`),
+	)
+}
+func tenecs_list_min() Function {
+	return function(
+		params("list", "comparator"),
+		body(`let result = null
+list.forEach((elem, i) => {
+  if (i == 0 || comparator.compare(elem, result)["$type"] === "Less") {
+    result = elem
+  }
+})
+return result`),
+	)
+}
+func tenecs_list_sortBy() Function {
+	return function(
+		params("list", "comparator"),
+		body(`// Array.prototype.sort is stable
+return [...list].sort((a, b) => {
+  const ordering = comparator.compare(a, b)["$type"]
+  return ordering === "Less" ? -1 : ordering === "Greater" ? 1 : 0
+})`),
+	)
+}
+func tenecs_list_all() Function {
+	return function(
+		params("list", "predicate"),
+		body(`return list.every((elem) => predicate(elem))`),
+	)
+}
+func tenecs_list_any() Function {
+	return function(
+		params("list", "predicate"),
+		body(`return list.some((elem) => predicate(elem))`),
+	)
+}
+func tenecs_list_chunked() Function {
+	return function(
+		params("list", "size"),
+		body(intAsNumberHelper+`const n = intAsNumber(size)
+if (n < 1) {
+  return ({
+    "$type": "Error",
+    "message": "Chunk size must be positive",
+    "cause": null,
+    "code": null,
+    "details": []
+  })
+}
+let result = []
+for (let start = 0; start < list.length; start += n) {
+  result.push(list.slice(start, start + n))
+}
+return result`),
+	)
+}
+func tenecs_list_distinct() Function {
+	return function(
+		params("list"),
+		body(areDeeplyEqualHelper+`let result = []
+for (const elem of list) {
+  if (!result.some((kept) => areDeeplyEqual(elem, kept))) {
+    result.push(elem)
+  }
+}
+return result`),
+	)
+}
+func tenecs_list_drop() Function {
+	return function(
+		params("list", "count"),
+		body(intAsNumberHelper+`return list.slice(Math.max(intAsNumber(count), 0))`),
+	)
+}
+func tenecs_list_Group() Function {
+	return structFunction(standard_library.Tenecs_list_Group)
+}
+func tenecs_list_groupBy() Function {
+	return function(
+		params("list", "key"),
+		body(areDeeplyEqualHelper+`let result = []
+for (const elem of list) {
+  const k = key(elem)
+  const group = result.find((g) => areDeeplyEqual(g.key, k))
+  if (group) {
+    group.values.push(elem)
+  } else {
+    result.push({ "$type": "Group", "key": k, "values": [elem] })
+  }
+}
+return result`),
+	)
+}
+func tenecs_list_Indexed() Function {
+	return structFunction(standard_library.Tenecs_list_Indexed)
+}
+func tenecs_list_indexed() Function {
+	return function(
+		params("list"),
+		body(`return list.map((elem, i) => ({ "$type": "Indexed", "index": i, "value": elem }))`),
+	)
+}
+func tenecs_list_last() Function {
+	return function(
+		params("list"),
+		body(`if (list.length == 0) {
+  return null
+}
+return list[list.length - 1]`),
+	)
+}
+func tenecs_list_Pair() Function {
+	return structFunction(standard_library.Tenecs_list_Pair)
+}
+func tenecs_list_Partition() Function {
+	return structFunction(standard_library.Tenecs_list_Partition)
+}
+func tenecs_list_partition() Function {
+	return function(
+		params("list", "predicate"),
+		body(`let matching = []
+let notMatching = []
+for (const elem of list) {
+  if (predicate(elem)) {
+    matching.push(elem)
+  } else {
+    notMatching.push(elem)
+  }
+}
+return ({ "$type": "Partition", "matching": matching, "notMatching": notMatching })`),
+	)
+}
+func tenecs_list_range() Function {
+	return function(
+		params("from", "until"),
+		body(intFromBigIntHelper+`let result = []
+if (typeof from === "number" && typeof until === "number" && until <= Number.MAX_SAFE_INTEGER) {
+  for (let i = from; i < until; i++) {
+    result.push(i)
+  }
+  return result
+}
+for (let i = BigInt(from); i < BigInt(until); i++) {
+  result.push(intFromBigInt(i))
+}
+return result`),
+	)
+}
+func tenecs_list_sum() Function {
+	return function(
+		params("list"),
+		body(intFromBigIntHelper+`return list.reduce((acc, elem) => {
+  if (typeof acc === "number" && typeof elem === "number" && Number.isSafeInteger(acc + elem)) {
+    return acc + elem
+  }
+  return intFromBigInt(BigInt(acc) + BigInt(elem))
+}, 0)`),
+	)
+}
+func tenecs_list_take() Function {
+	return function(
+		params("list", "count"),
+		body(intAsNumberHelper+`return list.slice(0, Math.max(intAsNumber(count), 0))`),
+	)
+}
+func tenecs_list_zip() Function {
+	return function(
+		params("left", "right"),
+		body(`let result = []
+for (let i = 0; i < left.length && i < right.length; i++) {
+  result.push({ "$type": "Pair", "left": left[i], "right": right[i] })
+}
+return result`),
+	)
+}
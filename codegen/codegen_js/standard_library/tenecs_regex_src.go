@@ -0,0 +1,178 @@
+// ##################################################################
+// # The signatures of this file are generated via code-generation. #
+// # Check gen.go                                                   #
+// ##################################################################
+package standard_library
+
+import "github.com/xplosunn/tenecs/typer/standard_library"
+
+// regexSourceHelper defines regexSource, which gives either what in the pattern is outside of the syntax that Go and JS
+// agree on, as standard_library.RegexUnsupportedSyntax does, or the source for a JS RegExp that behaves like Go's regexp.
+const regexSourceHelper = `function regexSource(pattern) {
+  const chars = [...pattern]
+  let source = ""
+  let inClass = false
+  for (let i = 0; i < chars.length; i++) {
+    const c = chars[i]
+    const next = (offset) => chars[i + offset] || ""
+    if (c === "\\") {
+      const e = next(1)
+      i++
+      if (e === "") {
+        source += c
+      } else if ("^$\\.*+?()[]{}|/dDwWntrfv".includes(e) || (e === "-" && inClass) || ((e === "b" || e === "B") && !inClass)) {
+        source += c + e
+      } else if (e === "s") {
+        source += inClass ? "\\t\\n\\f\\r " : "[\\t\\n\\f\\r ]"
+      } else if (e === "S" && !inClass) {
+        source += "[^\\t\\n\\f\\r ]"
+      } else if (e >= "0" && e <= "9") {
+        return { "unsupported": "backreferences" }
+      } else {
+        return { "unsupported": "the escape \\" + e + (inClass ? " inside a character class" : "") }
+      }
+    } else if (inClass) {
+      if (c === "[") {
+        return { "unsupported": "an unescaped [ inside a character class" }
+      }
+      if (c === "]") {
+        inClass = false
+      }
+      source += c
+    } else if (c === "[") {
+      inClass = true
+      source += c
+      if (next(1) === "^") {
+        i++
+        source += "^"
+      }
+      if (next(1) === "]") {
+        return { "unsupported": "an unescaped ] at the start of a character class" }
+      }
+    } else if (c === "(" && next(1) === "?") {
+      if (next(2) === ":") {
+        i += 2
+        source += "(?:"
+      } else if (next(2) === "=" || next(2) === "!" || (next(2) === "<" && (next(3) === "=" || next(3) === "!"))) {
+        return { "unsupported": "lookarounds" }
+      } else if (next(2) === "<" || next(2) === "P") {
+        return { "unsupported": "named groups" }
+      } else {
+        return { "unsupported": "inline flags" }
+      }
+    } else if (c === "{") {
+      const repetition = /^\{([0-9]+)(,([0-9]*))?\}/.exec(chars.slice(i).join(""))
+      if (!repetition) {
+        return { "unsupported": "an unescaped {" }
+      }
+      if (Number(repetition[1]) > 1000 || Number(repetition[3] || "0") > 1000) {
+        return { "unsupported": "a repetition count over 1000" }
+      }
+      source += repetition[0]
+      i += repetition[0].length - 1
+    } else if (c === "]" || c === "}") {
+      return { "unsupported": "an unescaped " + c }
+    } else if (c === ".") {
+      source += "[^\\n]"
+    } else {
+      source += c
+    }
+  }
+  return { "source": source }
+}
+`
+
+// regexMatchesHelper defines regexMatches, which finds the matches like Go's regexp does, skipping empty matches right
+// after a previous match. matchAll works on a copy of the compiled RegExp, so the lastIndex of the Regex stays put.
+const regexMatchesHelper = `function regexMatches(regex, str) {
+  const result = []
+  let previousEnd = -1
+  for (const match of str.matchAll(regex.compiled)) {
+    if (match[0] === "" && match.index === previousEnd) {
+      continue
+    }
+    previousEnd = match.index + match[0].length
+    result.push(match)
+  }
+  return result
+}
+function regexMatch(match) {
+  return ({
+    "$type": "Match",
+    "text": match[0],
+    "groups": match.slice(1).map((group) => group === undefined ? null : group)
+  })
+}
+`
+
+func tenecs_regex_Match() Function {
+	return structFunction(standard_library.Tenecs_regex_Match)
+}
+func tenecs_regex_Regex() Function {
+	return structFunction(standard_library.Tenecs_regex_Regex)
+}
+func tenecs_regex_compile() Function {
+	return function(
+		params("pattern"),
+		body(regexSourceHelper+`const compiled = regexSource(pattern)
+let message = null
+let regex = null
+if (compiled.unsupported) {
+  message = JSON.stringify(pattern) + " is not a supported regex, it uses " + compiled.unsupported
+} else {
+  try {
+    regex = ({
+      "$type": "Regex",
+      "compiled": new RegExp(compiled.source, "gu"),
+      "anchored": new RegExp("^(?:" + compiled.source + ")$", "u")
+    })
+  } catch (e) {
+    message = JSON.stringify(pattern) + " is not a valid regex"
+  }
+}
+if (message != null) {
+  return ({
+    "$type": "Error",
+    "message": message,
+    "cause": null,
+    "code": null,
+    "details": []
+  })
+}
+return regex`),
+	)
+}
+func tenecs_regex_find() Function {
+	return function(
+		params("regex", "str"),
+		body(regexMatchesHelper+`const matches = regexMatches(regex, str)
+if (matches.length == 0) {
+  return null
+}
+return regexMatch(matches[0])`),
+	)
+}
+func tenecs_regex_findAll() Function {
+	return function(
+		params("regex", "str"),
+		body(regexMatchesHelper+`return regexMatches(regex, str).map(regexMatch)`),
+	)
+}
+func tenecs_regex_matches() Function {
+	return function(
+		params("regex", "str"),
+		body(`return regex.anchored.test(str)`),
+	)
+}
+func tenecs_regex_replaceAll() Function {
+	return function(
+		params("regex", "str", "replacement"),
+		body(regexMatchesHelper+`let result = ""
+let previousEnd = 0
+for (const match of regexMatches(regex, str)) {
+  result += str.substring(previousEnd, match.index) + replacement
+  previousEnd = match.index + match[0].length
+}
+return result + str.substring(previousEnd)`),
+	)
+}
@@ -50,7 +50,7 @@ func tenecs_string_characters() Function {
 func tenecs_string_padLeft() Function {
 	return function(
 		params("str", "length", "padChar"),
-		body(`return str.padStart(length, padChar || " ")`),
+		body(intAsNumberHelper+`return str.padStart(intAsNumber(length), padChar || " ")`),
 	)
 }
 func tenecs_string_toLowerCase() Function {
@@ -74,13 +74,13 @@ func tenecs_string_reverse() Function {
 func tenecs_string_length() Function {
 	return function(
 		params("str"),
-		body(`return str.length`),
+		body(`return [...str].length`),
 	)
 }
 func tenecs_string_repeat() Function {
 	return function(
 		params("str", "count"),
-		body(`return str.repeat(count)`),
+		body(intAsNumberHelper+`return str.repeat(intAsNumber(count))`),
 	)
 }
 func tenecs_string_isBlank() Function {
@@ -98,7 +98,7 @@ func tenecs_string_isEmpty() Function {
 func tenecs_string_padRight() Function {
 	return function(
 		params("str", "length", "padChar"),
-		body(`return str.padEnd(length, padChar || " ")`),
+		body(intAsNumberHelper+`return str.padEnd(intAsNumber(length), padChar || " ")`),
 	)
 }
 func tenecs_string_toUpperCase() Function {
@@ -122,12 +122,136 @@ func tenecs_string_trimRight() Function {
 func tenecs_string_firstCharCode() Function {
 	return function(
 		params("str"),
-		body(`return str.length ? str.charCodeAt(0) : -1`),
+		body(`return str.length ? str.codePointAt(0) : -1`),
 	)
 }
 func tenecs_string_firstChar() Function {
 	return function(
 		params("str"),
-		body(`return str.substring(0, 1)`),
+		body(`return str.length ? String.fromCodePoint(str.codePointAt(0)) : ""`),
+	)
+}
+func tenecs_string_fromInt() Function {
+	return function(
+		params("value"),
+		body(`return String(value)`),
+	)
+}
+func tenecs_string_indexOf() Function {
+	return function(
+		params("str", "subStr"),
+		body(`const index = str.indexOf(subStr)
+if (index < 0) {
+  return null
+}
+return [...str.substring(0, index)].length`),
+	)
+}
+func tenecs_string_lines() Function {
+	return function(
+		params("str"),
+		body(`if (str === "") {
+  return []
+}
+return str.replace(/\r?\n$/, "").split("\n").map((line) => line.replace(/\r$/, ""))`),
+	)
+}
+func tenecs_string_replace() Function {
+	return function(
+		params("str", "target", "replacement"),
+		body(`if (target === "") {
+  return [...str].map((c) => replacement + c).join("") + replacement
+}
+return str.split(target).join(replacement)`),
+	)
+}
+func tenecs_string_split() Function {
+	return function(
+		params("str", "separator"),
+		body(`if (separator === "") {
+  return [...str]
+}
+return str.split(separator)`),
+	)
+}
+func tenecs_string_substring() Function {
+	return function(
+		params("str", "start", "end"),
+		body(intAsNumberHelper+`const chars = [...str]
+const from = Math.min(Math.max(intAsNumber(start), 0), chars.length)
+const until = Math.min(Math.max(intAsNumber(end), from), chars.length)
+return chars.slice(from, until).join("")`),
+	)
+}
+func tenecs_string_toInt() Function {
+	return function(
+		params("str"),
+		body(intFromBigIntHelper+`let message = JSON.stringify(str) + " is not an Int"
+if (/^[+-]?[0-9]+$/.test(str)) {
+  const result = BigInt(str)
+  if (BigInt.asIntN(64, result) === result) {
+    return intFromBigInt(result)
+  }
+  message = JSON.stringify(str) + " is out of range for an Int"
+}
+return ({
+  "$type": "Error",
+  "message": message,
+  "cause": null,
+  "code": null,
+  "details": []
+})`),
+	)
+}
+func tenecs_string_chars() Function {
+	return function(
+		params("str"),
+		body(`return [...str].map((c) => ({ "$type": "Char", "codePoint": c.codePointAt(0) }))`),
+	)
+}
+func tenecs_string_fromChars() Function {
+	return function(
+		params("chars"),
+		body(`return chars.map((c) => String.fromCodePoint(c.codePoint)).join("")`),
+	)
+}
+func tenecs_string_graphemes() Function {
+	return function(
+		params("str"),
+		body(`const isRegionalIndicator = (c) => c >= 0x1F1E6 && c <= 0x1F1FF
+const isControl = (c) => /\p{Cc}/u.test(String.fromCodePoint(c))
+const isExtend = (c) => /\p{M}/u.test(String.fromCodePoint(c)) || c == 0x200D || (c >= 0x1F3FB && c <= 0x1F3FF) || (c >= 0xE0020 && c <= 0xE007F)
+let result = []
+let cluster = []
+let regionalIndicators = 0
+for (const char of str) {
+  const c = char.codePointAt(0)
+  if (cluster.length > 0) {
+    const prev = cluster[cluster.length - 1]
+    let together = false
+    if (prev == 0x0D && c == 0x0A) {
+      together = true
+    } else if (isControl(prev) || isControl(c)) {
+      together = false
+    } else if (isExtend(c) || prev == 0x200D) {
+      together = true
+    } else if (isRegionalIndicator(prev) && isRegionalIndicator(c) && regionalIndicators % 2 == 1) {
+      together = true
+    }
+    if (!together) {
+      result.push(String.fromCodePoint(...cluster))
+      cluster = []
+      regionalIndicators = 0
+    }
+  }
+  cluster.push(c)
+  if (isRegionalIndicator(c)) {
+    regionalIndicators++
+  }
+}
+if (cluster.length > 0) {
+  result.push(String.fromCodePoint(...cluster))
+}
+return result`),
 	)
 }
@@ -28,3 +28,12 @@ func tenecs_test_GoIntegrationTest() Function {
 func tenecs_test_GoIntegrationTestKit() Function {
 	return structFunction(standard_library.Tenecs_test_GoIntegrationTestKit)
 }
+func tenecs_test_FakeClock() Function {
+	return structFunction(standard_library.Tenecs_test_FakeClock)
+}
+func tenecs_test_FakeConsole() Function {
+	return structFunction(standard_library.Tenecs_test_FakeConsole)
+}
+func tenecs_test_FakeProcess() Function {
+	return structFunction(standard_library.Tenecs_test_FakeProcess)
+}
@@ -44,3 +44,265 @@ return ({
 })`),
 	)
 }
+func tenecs_time_DateTime() Function {
+	return structFunction(standard_library.Tenecs_time_DateTime)
+}
+func tenecs_time_Duration() Function {
+	return structFunction(standard_library.Tenecs_time_Duration)
+}
+func tenecs_time_Instant() Function {
+	return structFunction(standard_library.Tenecs_time_Instant)
+}
+func tenecs_time_durationBetween() Function {
+	return function(
+		params("from", "to"),
+		body(`return ({
+  "$type": "Duration",
+  "milliseconds": to.epochMilliseconds - from.epochMilliseconds
+})`),
+	)
+}
+func tenecs_time_fromDateTime() Function {
+	return function(
+		params("dateTime", "timeZone"),
+		body(`let format = null
+try {
+  format = new Intl.DateTimeFormat("en-US", { timeZone: timeZone, hourCycle: "h23", year: "numeric", month: "numeric", day: "numeric", hour: "numeric", minute: "numeric", second: "numeric" })
+} catch (e) {
+  return ({
+    "$type": "Error",
+    "message": "unknown time zone " + timeZone,
+    "cause": null,
+    "code": null,
+    "details": []
+  })
+}
+const offsetAt = (epochMilliseconds) => {
+  const parts = {}
+  for (const part of format.formatToParts(new Date(epochMilliseconds))) {
+    parts[part.type] = Number(part.value)
+  }
+  return Date.UTC(parts.year, parts.month - 1, parts.day, parts.hour, parts.minute, parts.second) - Math.floor(epochMilliseconds / 1000) * 1000
+}
+const asUtc = Date.UTC(dateTime.date.year, dateTime.date.month - 1, dateTime.date.day, dateTime.hour, dateTime.minute, dateTime.second, dateTime.millisecond)
+let epochMilliseconds = asUtc - offsetAt(asUtc)
+const offset = offsetAt(epochMilliseconds)
+if (asUtc - offset !== epochMilliseconds) {
+  epochMilliseconds = asUtc - offset
+}
+return ({
+  "$type": "Instant",
+  "epochMilliseconds": epochMilliseconds
+})`),
+	)
+}
+func tenecs_time_hours() Function {
+	return function(
+		params("amount"),
+		body(`return ({
+  "$type": "Duration",
+  "milliseconds": amount * 60 * 60 * 1000
+})`),
+	)
+}
+func tenecs_time_instantIsAfter() Function {
+	return function(
+		params("instant", "other"),
+		body(`return instant.epochMilliseconds > other.epochMilliseconds`),
+	)
+}
+func tenecs_time_instantIsBefore() Function {
+	return function(
+		params("instant", "other"),
+		body(`return instant.epochMilliseconds < other.epochMilliseconds`),
+	)
+}
+func tenecs_time_minusDuration() Function {
+	return function(
+		params("instant", "duration"),
+		body(`return ({
+  "$type": "Instant",
+  "epochMilliseconds": instant.epochMilliseconds - duration.milliseconds
+})`),
+	)
+}
+func tenecs_time_minutes() Function {
+	return function(
+		params("amount"),
+		body(`return ({
+  "$type": "Duration",
+  "milliseconds": amount * 60 * 1000
+})`),
+	)
+}
+func tenecs_time_plusDuration() Function {
+	return function(
+		params("instant", "duration"),
+		body(`return ({
+  "$type": "Instant",
+  "epochMilliseconds": instant.epochMilliseconds + duration.milliseconds
+})`),
+	)
+}
+func tenecs_time_seconds() Function {
+	return function(
+		params("amount"),
+		body(`return ({
+  "$type": "Duration",
+  "milliseconds": amount * 1000
+})`),
+	)
+}
+func tenecs_time_toDateTime() Function {
+	return function(
+		params("instant", "timeZone"),
+		body(`let format = null
+try {
+  format = new Intl.DateTimeFormat("en-US", { timeZone: timeZone, hourCycle: "h23", year: "numeric", month: "numeric", day: "numeric", hour: "numeric", minute: "numeric", second: "numeric" })
+} catch (e) {
+  return ({
+    "$type": "Error",
+    "message": "unknown time zone " + timeZone,
+    "cause": null,
+    "code": null,
+    "details": []
+  })
+}
+const epochMilliseconds = instant.epochMilliseconds
+const parts = {}
+for (const part of format.formatToParts(new Date(epochMilliseconds))) {
+  parts[part.type] = Number(part.value)
+}
+return ({
+  "$type": "DateTime",
+  "date": ({
+    "$type": "Date",
+    "year": parts.year,
+    "month": parts.month,
+    "day": parts.day
+  }),
+  "hour": parts.hour,
+  "minute": parts.minute,
+  "second": parts.second,
+  "millisecond": ((epochMilliseconds % 1000) + 1000) % 1000
+})`),
+	)
+}
+func tenecs_time_dayOfWeek() Function {
+	return function(
+		params("date"),
+		body(`const d = new Date(0)
+d.setUTCFullYear(date.year, date.month - 1, date.day)
+return (d.getUTCDay() + 6) % 7 + 1`),
+	)
+}
+func tenecs_time_daysBetween() Function {
+	return function(
+		params("from", "to"),
+		body(`const fromDate = new Date(0)
+fromDate.setUTCFullYear(from.year, from.month - 1, from.day)
+const toDate = new Date(0)
+toDate.setUTCFullYear(to.year, to.month - 1, to.day)
+return Math.trunc((toDate.getTime() - fromDate.getTime()) / 86400000)`),
+	)
+}
+func tenecs_time_format() Function {
+	return function(
+		params("date"),
+		body(`return String(date.year).padStart(4, "0") + "-" + String(date.month).padStart(2, "0") + "-" + String(date.day).padStart(2, "0")`),
+	)
+}
+func tenecs_time_isAfter() Function {
+	return function(
+		params("date", "other"),
+		body(`if (date.year !== other.year) {
+  return date.year > other.year
+}
+if (date.month !== other.month) {
+  return date.month > other.month
+}
+return date.day > other.day`),
+	)
+}
+func tenecs_time_isBefore() Function {
+	return function(
+		params("date", "other"),
+		body(`if (date.year !== other.year) {
+  return date.year < other.year
+}
+if (date.month !== other.month) {
+  return date.month < other.month
+}
+return date.day < other.day`),
+	)
+}
+
+// timeParseDateHelper is the yyyy-mm-dd format shared by parse and jsonDate
+const timeParseDateHelper = `const parseDate = (text) => {
+  const match = /^(\d{4})-(\d{2})-(\d{2})$/.exec(text)
+  if (match) {
+    const year = Number(match[1])
+    const month = Number(match[2])
+    const day = Number(match[3])
+    const d = new Date(0)
+    d.setUTCFullYear(year, month - 1, day)
+    if (d.getUTCFullYear() === year && d.getUTCMonth() === month - 1 && d.getUTCDate() === day) {
+      return ({ "$type": "Date", "year": year, "month": month, "day": day })
+    }
+  }
+  return null
+}
+`
+
+func tenecs_time_jsonDate() Function {
+	return function(
+		body(jsonExpectedHelper + timeParseDateHelper + `return ({
+  "$type": "JsonConverter",
+  "fromJson": (input) => {
+    try {
+      const text = JSON.parse(input)
+      const date = typeof text == "string" ? parseDate(text) : null
+      if (date) {
+        return date
+      }
+    } catch (e) {}
+    return jsonExpected("Date", input)
+  },
+  "toJson": (input) => {
+    return "\"" + String(input.year).padStart(4, "0") + "-" + String(input.month).padStart(2, "0") + "-" + String(input.day).padStart(2, "0") + "\""
+  },
+})`),
+	)
+}
+func tenecs_time_parse() Function {
+	return function(
+		params("text"),
+		body(timeParseDateHelper+`const date = parseDate(text)
+if (date) {
+  return date
+}
+return ({
+  "$type": "Error",
+  "message": "Could not parse Date from " + text,
+  "cause": null,
+  "code": null,
+  "details": []
+})`),
+	)
+}
+func tenecs_time_plusMonths() Function {
+	return function(
+		params("date", "months"),
+		body(`const total = date.year * 12 + date.month - 1 + months
+const year = Math.floor(total / 12)
+const month = total - year * 12 + 1
+const lastDay = new Date(0)
+lastDay.setUTCFullYear(year, month, 0)
+return ({
+  "$type": "Date",
+  "year": year,
+  "month": month,
+  "day": Math.min(date.day, lastDay.getUTCDate())
+})`),
+	)
+}
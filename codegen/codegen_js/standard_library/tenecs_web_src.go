@@ -18,3 +18,39 @@ func tenecs_web_HtmlElementProperty() Function {
 func tenecs_web_CssUrl() Function {
 	return structFunction(standard_library.Tenecs_web_CssUrl)
 }
+func tenecs_web_httpGet() Function {
+	return function(
+		params("url", "onResult"),
+		body(`fetch(url).then(async (response) => {
+  const headers = []
+  response.headers.forEach((value, name) => headers.push({ "$type": "Header", "name": name, "value": value }))
+  return { "$type": "Response", "status": response.status, "headers": headers, "body": await response.text() }
+}, (error) => {
+  return { "$type": "Error", "message": error instanceof Error ? error.message : String(error), "cause": null, "code": null, "details": [] }
+}).then((result) => {
+  const event = onResult(result)
+  if (typeof updateState === "function") {
+    updateState(event)
+  }
+})
+return null`),
+	)
+}
+func tenecs_web_httpPost() Function {
+	return function(
+		params("url", "contentType", "body", "onResult"),
+		body(`fetch(url, { method: "POST", headers: { "Content-Type": contentType }, body: body }).then(async (response) => {
+  const headers = []
+  response.headers.forEach((value, name) => headers.push({ "$type": "Header", "name": name, "value": value }))
+  return { "$type": "Response", "status": response.status, "headers": headers, "body": await response.text() }
+}, (error) => {
+  return { "$type": "Error", "message": error instanceof Error ? error.message : String(error), "cause": null, "code": null, "details": [] }
+}).then((result) => {
+  const event = onResult(result)
+  if (typeof updateState === "function") {
+    updateState(event)
+  }
+})
+return null`),
+	)
+}
@@ -66,26 +66,29 @@ function areDeeplyEqual(obj1, obj2) {
   return false;
 }
 
-let testkit = {
-  "assert": {
-    "equal": (expected, value) => {
-      if (!areDeeplyEqual(expected, value)) {
-        throw new Error(testEqualityErrorMessage(expected, value))
+function createUnitTestKit() {
+  return ({
+    "assert": {
+      "equal": (expected, value) => {
+        if (!areDeeplyEqual(expected, value)) {
+          throw new Error(testEqualityErrorMessage(expected, value))
+        }
+        return null
+      },
+      "fail": (message) => {
+        throw new Error(message)
       }
-      return null
     },
-    "fail": (message) => {
-      throw new Error(message)
-    }
-  },
-  "ref": %s
+    "fakeClock": %s,
+    "ref": %s
+  })
 }
 
 function createTestRegistry() {
   return ({
     "test": (name, theTest) => {
       try {
-        theTest(testkit)
+        theTest(createUnitTestKit())
         console.log("  [\u001b[32mOK\u001b[0m]", name)
         testSummary.runOk += 1
       } catch (e) {
@@ -103,9 +106,18 @@ function createTestRegistry() {
 }
 
 function testEqualityErrorMessage(value, expected) {
-  return JSON.stringify(value) + " is not equal to " + JSON.stringify(expected)
+  const isChar = (value) => value !== null && typeof value === "object" && value["$type"] === "Char"
+  const format = (value) => isChar(value) ? "'" + String.fromCodePoint(value.codePoint) + "'" : JSON.stringify(value, (key, value) => {
+    if (typeof value === "bigint") {
+      return value.toString()
+    } else if (isChar(value)) {
+      return "'" + String.fromCodePoint(value.codePoint) + "'"
+    }
+    return value
+  })
+  return format(value) + " is not equal to " + format(expected)
 }
-`, ref)
+`, runtimeFakeClock(), ref)
 
 	return result
 }
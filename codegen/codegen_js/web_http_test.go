@@ -0,0 +1,79 @@
+package codegen_js_test
+
+import (
+	"github.com/alecthomas/assert/v2"
+	"github.com/xplosunn/tenecs/codegen/codegen_js"
+	"github.com/xplosunn/tenecs/desugar"
+	"github.com/xplosunn/tenecs/external/node"
+	"github.com/xplosunn/tenecs/parser"
+	"github.com/xplosunn/tenecs/typer"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebHttpDispatchesResultAsEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(201)
+		w.Write([]byte(r.Method + " " + r.URL.Path + " " + r.Header.Get("Content-Type") + " " + string(body)))
+	}))
+	defer server.Close()
+
+	program := `package web
+
+import tenecs.error.Error
+import tenecs.http.Response
+import tenecs.string.join
+import tenecs.web.httpGet
+import tenecs.web.httpPost
+
+describe := (result: Response | Error): String => {
+  when result {
+    is response: Response => {
+      join("response: ", response.body)
+    }
+    is e: Error => {
+      "error"
+    }
+  }
+}
+
+get := (url: String): Void => {
+  httpGet(url, describe)
+}
+
+post := (url: String): Void => {
+  httpPost(url, "text/plain", "hello", describe)
+}
+`
+
+	parsed, err := parser.ParseString(program)
+	assert.NoError(t, err)
+
+	desugared, err := desugar.Desugar(*parsed)
+	assert.NoError(t, err)
+
+	typed, err := typer.TypecheckSingleFile(desugared)
+	assert.NoError(t, err)
+
+	js := codegen_js.GenerateProgramNonRunnable(typed) + `
+const events = []
+function updateState(event) {
+  events.push(event)
+  if (events.length === 3) {
+    console.log(events.sort().join("\n"))
+  }
+}
+web__get("` + server.URL + `/a")
+web__post("` + server.URL + `/b")
+web__get("http://localhost:1/unreachable")
+`
+	output, err := node.RunCodeBlockingAndReturningOutputWhenFinished(t, js)
+	assert.NoError(t, err)
+	assert.Equal(t, `error
+response: GET /a  
+response: POST /b text/plain hello
+`, output)
+}
@@ -0,0 +1,261 @@
+package codegen
+
+import (
+	"fmt"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"github.com/xplosunn/tenecs/typer/binding"
+	"github.com/xplosunn/tenecs/typer/standard_library"
+	"github.com/xplosunn/tenecs/typer/types"
+	"sort"
+	"strings"
+)
+
+type DerivedJsonKind string
+
+const (
+	DerivedJsonString  DerivedJsonKind = "String"
+	DerivedJsonInt     DerivedJsonKind = "Int"
+	DerivedJsonFloat   DerivedJsonKind = "Float"
+	DerivedJsonBoolean DerivedJsonKind = "Boolean"
+	DerivedJsonVoid    DerivedJsonKind = "Void"
+	DerivedJsonList    DerivedJsonKind = "List"
+	DerivedJsonOr      DerivedJsonKind = "Or"
+	DerivedJsonStruct  DerivedJsonKind = "Struct"
+)
+
+// DerivedJsonType is what a derived converter needs to know about one type.
+// Other types are referred to by their DerivedJsonTypeName, which keeps recursive types finite.
+type DerivedJsonType struct {
+	Kind DerivedJsonKind
+	// Of is the element of a List or the alternatives of an Or.
+	// The typer makes sure each alternative is read from a different kind of json,
+	// apart from structs, which are told apart by a "$type" with their name when there's more than one.
+	Of []string
+	// Struct and Fields are only set for a Struct, Fields being in constructor order
+	Struct *types.KnownType
+	Fields []DerivedJsonField
+}
+
+type DerivedJsonField struct {
+	Name     string
+	TypeName string
+}
+
+func DerivedJsonTypeName(varType types.VariableType) string {
+	return types.PrintableName(varType)
+}
+
+// JsonConverterDerivedType returns the type a converter is derived for if the invocation is jsonConverter<T>().
+func JsonConverterDerivedType(invocation ast.Invocation) (types.VariableType, bool) {
+	_, caseReference, _, _, _, _, _, _, _ := invocation.Over.ExpressionCases()
+	if caseReference == nil || caseReference.PackageName == nil {
+		return nil, false
+	}
+	if *caseReference.PackageName != "tenecs.json" || caseReference.Name != "jsonConverter" {
+		return nil, false
+	}
+	return invocation.Generics[0], true
+}
+
+// DeriveJsonTypes finds every jsonConverter<T>() in the program and describes all the types reachable from each T,
+// keyed by DerivedJsonTypeName.
+// The typer rejects the types that can't be derived, so an error here is a bug in either.
+func DeriveJsonTypes(program *ast.Program) (map[string]DerivedJsonType, error) {
+	result := map[string]DerivedJsonType{}
+	var err error
+	for _, expression := range program.Declarations {
+		forEachInvocation(expression, func(invocation ast.Invocation) {
+			varType, ok := JsonConverterDerivedType(invocation)
+			if ok && err == nil {
+				err = deriveJsonType(program, invocation.CodePoint, varType, result)
+			}
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SortedDerivedJsonTypeNames gives a stable order to generate the derived types in.
+func SortedDerivedJsonTypeNames(derived map[string]DerivedJsonType) []string {
+	names := []string{}
+	for name, _ := range derived {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func deriveJsonType(program *ast.Program, codePoint ast.CodePoint, varType types.VariableType, result map[string]DerivedJsonType) error {
+	name := DerivedJsonTypeName(varType)
+	if _, ok := result[name]; ok {
+		return nil
+	}
+	failure := func() error {
+		return fmt.Errorf("%s:%d: jsonConverter can't derive a converter for %s", codePoint.FileName, codePoint.Line, name)
+	}
+	caseTypeArgument, caseList, caseKnownType, caseFunction, caseOr := varType.VariableTypeCases()
+	if caseTypeArgument != nil {
+		return failure()
+	} else if caseList != nil {
+		result[name] = DerivedJsonType{
+			Kind: DerivedJsonList,
+			Of:   []string{DerivedJsonTypeName(caseList.Generic)},
+		}
+		return deriveJsonType(program, codePoint, caseList.Generic, result)
+	} else if caseKnownType != nil {
+		if caseKnownType.Package == "" {
+			kind := DerivedJsonKind(caseKnownType.Name)
+			if kind != DerivedJsonString && kind != DerivedJsonInt && kind != DerivedJsonFloat && kind != DerivedJsonBoolean && kind != DerivedJsonVoid {
+				return failure()
+			}
+			result[name] = DerivedJsonType{
+				Kind: kind,
+			}
+			return nil
+		}
+		fieldNames, fieldTypes, ok := structFields(program, caseKnownType)
+		if !ok {
+			return failure()
+		}
+		fields := []DerivedJsonField{}
+		for _, fieldName := range fieldNames {
+			fields = append(fields, DerivedJsonField{
+				Name:     fieldName,
+				TypeName: DerivedJsonTypeName(fieldTypes[fieldName]),
+			})
+		}
+		result[name] = DerivedJsonType{
+			Kind:   DerivedJsonStruct,
+			Struct: caseKnownType,
+			Fields: fields,
+		}
+		for _, fieldName := range fieldNames {
+			err := deriveJsonType(program, codePoint, fieldTypes[fieldName], result)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	} else if caseFunction != nil {
+		return failure()
+	} else if caseOr != nil {
+		elements := caseOr.Elements
+		if flattened, ok := types.FlattenOr(caseOr).(*types.OrVariableType); ok {
+			elements = flattened.Elements
+		}
+		of := []string{}
+		for _, element := range elements {
+			of = append(of, DerivedJsonTypeName(element))
+		}
+		result[name] = DerivedJsonType{
+			Kind: DerivedJsonOr,
+			Of:   of,
+		}
+		for _, element := range elements {
+			err := deriveJsonType(program, codePoint, element, result)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	} else {
+		panic("cases on variableType")
+	}
+}
+
+// structFields returns the field names in constructor order and their types with the struct generics applied.
+func structFields(program *ast.Program, knownType *types.KnownType) ([]string, map[string]types.VariableType, bool) {
+	fieldNames := []string{}
+	fieldTypes := map[string]types.VariableType{}
+	declaredGenerics := []string{}
+	structFunction, ok := program.StructFunctions[ast.Ref{
+		Package: knownType.Package,
+		Name:    knownType.Name,
+	}]
+	if ok {
+		for _, argument := range structFunction.Arguments {
+			fieldNames = append(fieldNames, argument.Name)
+			fieldTypes[argument.Name] = argument.VariableType
+		}
+		declaredGenerics = structFunction.Generics
+	} else {
+		pkg := standard_library.StdLib
+		for _, name := range strings.Split(knownType.Package, ".") {
+			pkg, ok = pkg.Packages[name]
+			if !ok {
+				return nil, nil, false
+			}
+		}
+		stdLibStruct, ok := pkg.Structs[knownType.Name]
+		if !ok {
+			return nil, nil, false
+		}
+		for _, fieldName := range stdLibStruct.FieldNamesSorted {
+			fieldNames = append(fieldNames, fieldName)
+			fieldTypes[fieldName] = stdLibStruct.Fields[fieldName]
+		}
+		declaredGenerics = stdLibStruct.Struct.DeclaredGenerics
+	}
+	if len(declaredGenerics) != len(knownType.Generics) {
+		return nil, nil, false
+	}
+	genericsMap := map[string]types.VariableType{}
+	for i, generic := range declaredGenerics {
+		genericsMap[generic] = knownType.Generics[i]
+	}
+	for fieldName, fieldType := range fieldTypes {
+		resolved, err := binding.ResolveGeneric(fieldType, genericsMap)
+		if err != nil {
+			return nil, nil, false
+		}
+		fieldTypes[fieldName] = resolved
+	}
+	return fieldNames, fieldTypes, true
+}
+
+func forEachInvocation(expression ast.Expression, f func(invocation ast.Invocation)) {
+	caseLiteral, caseReference, caseAccess, caseInvocation, caseFunction, caseDeclaration, caseIf, caseList, caseWhen := expression.ExpressionCases()
+	if caseLiteral != nil {
+	} else if caseReference != nil {
+	} else if caseAccess != nil {
+		forEachInvocation(caseAccess.Over, f)
+	} else if caseInvocation != nil {
+		f(*caseInvocation)
+		forEachInvocation(caseInvocation.Over, f)
+		for _, argument := range caseInvocation.Arguments {
+			forEachInvocation(argument, f)
+		}
+	} else if caseFunction != nil {
+		for _, exp := range caseFunction.Block {
+			forEachInvocation(exp, f)
+		}
+	} else if caseDeclaration != nil {
+		forEachInvocation(caseDeclaration.Expression, f)
+	} else if caseIf != nil {
+		forEachInvocation(caseIf.Condition, f)
+		for _, exp := range caseIf.ThenBlock {
+			forEachInvocation(exp, f)
+		}
+		for _, exp := range caseIf.ElseBlock {
+			forEachInvocation(exp, f)
+		}
+	} else if caseList != nil {
+		for _, exp := range caseList.Arguments {
+			forEachInvocation(exp, f)
+		}
+	} else if caseWhen != nil {
+		forEachInvocation(caseWhen.Over, f)
+		for _, whenCase := range caseWhen.Cases {
+			for _, exp := range whenCase.Block {
+				forEachInvocation(exp, f)
+			}
+		}
+		for _, exp := range caseWhen.OtherCase {
+			forEachInvocation(exp, f)
+		}
+	} else {
+		panic(fmt.Errorf("cases on %v", expression))
+	}
+}
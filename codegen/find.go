@@ -46,6 +46,14 @@ func FindRunnables(program *ast.Program) Runnables {
 	return runnables
 }
 
+// FilterRunnablesDeclaredIn keeps only the runnables declared in one of the files.
+func FilterRunnablesDeclaredIn(program *ast.Program, runnables Runnables, files []string) Runnables {
+	return Runnables{
+		GoMain:    filterDeclaredIn(program, runnables.GoMain, files),
+		WebWebApp: filterDeclaredIn(program, runnables.WebWebApp, files),
+	}
+}
+
 type FoundTests struct {
 	UnitTests          []ast.Ref
 	UnitTestSuites     []ast.Ref
@@ -93,6 +101,25 @@ func FindTests(program *ast.Program) FoundTests {
 	return found
 }
 
+// FilterTestsDeclaredIn keeps only the tests declared in one of the files.
+func FilterTestsDeclaredIn(program *ast.Program, tests FoundTests, files []string) FoundTests {
+	return FoundTests{
+		UnitTests:          filterDeclaredIn(program, tests.UnitTests, files),
+		UnitTestSuites:     filterDeclaredIn(program, tests.UnitTestSuites, files),
+		GoIntegrationTests: filterDeclaredIn(program, tests.GoIntegrationTests, files),
+	}
+}
+
+func filterDeclaredIn(program *ast.Program, refs []ast.Ref, files []string) []ast.Ref {
+	result := []ast.Ref{}
+	for _, ref := range refs {
+		if slices.Contains(files, program.Declarations[ref].SourceCodePoint().FileName) {
+			result = append(result, ref)
+		}
+	}
+	return result
+}
+
 type CachedTestCount struct {
 	UnitTests          int
 	UnitTestSuites     int
@@ -371,6 +371,9 @@ func GenerateLiteral(literal parser.Literal) string {
 	switch l := literal.(type) {
 	case parser.LiteralString:
 		return l.Value
+	case parser.LiteralChar:
+		unquoted, _ := strconv.Unquote(l.Value)
+		return strconv.QuoteRune([]rune(unquoted)[0])
 	case parser.LiteralInt:
 		value := l.Value
 		if l.Negative {
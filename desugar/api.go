@@ -37,6 +37,7 @@ func TopLevelDeclarationExhaustiveSwitch(
 	caseDeclaration func(topLevelDeclaration Declaration),
 	caseStruct func(topLevelDeclaration Struct),
 	caseTypeAlias func(topLevelDeclaration TypeAlias),
+	caseExternal func(topLevelDeclaration External),
 ) {
 	declaration, ok := topLevelDeclaration.(Declaration)
 	if ok {
@@ -53,6 +54,11 @@ func TopLevelDeclarationExhaustiveSwitch(
 		caseTypeAlias(typeAlias)
 		return
 	}
+	external, ok := topLevelDeclaration.(External)
+	if ok {
+		caseExternal(external)
+		return
+	}
 }
 
 type Struct struct {
@@ -76,6 +82,17 @@ type TypeAlias struct {
 
 func (i TypeAlias) sealedTopLevelDeclaration() {}
 
+type External struct {
+	parser.Node
+	Name   Name
+	Type   TypeAnnotation
+	Target Name
+	Module string
+	Symbol string
+}
+
+func (e External) sealedTopLevelDeclaration() {}
+
 type TypeAnnotation struct {
 	parser.Node
 	OrTypes []TypeAnnotationElement `@@ ("|" @@)*`
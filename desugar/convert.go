@@ -2,6 +2,7 @@ package desugar
 
 import (
 	"github.com/xplosunn/tenecs/parser"
+	"strconv"
 )
 
 func ConvertFunctionType(parsed parser.FunctionType) FunctionType {
@@ -66,10 +67,32 @@ func convertTopLevelDeclaration(parsed parser.TopLevelDeclaration) TopLevelDecla
 		func(parsed parser.TypeAlias) {
 			result = convertTypeAlias(parsed)
 		},
+		func(parsed parser.External) {
+			result = convertExternal(parsed)
+		},
 	)
 	return result
 }
 
+func convertExternal(parsed parser.External) External {
+	return External{
+		Node:   parsed.Node,
+		Name:   convertName(parsed.Name),
+		Type:   convertTypeAnnotation(parsed.Type),
+		Target: convertName(parsed.Target),
+		Module: unquoteExternalString(parsed.Module),
+		Symbol: unquoteExternalString(parsed.Symbol),
+	}
+}
+
+func unquoteExternalString(quoted string) string {
+	unquoted, err := strconv.Unquote(quoted)
+	if err != nil {
+		return quoted
+	}
+	return unquoted
+}
+
 func convertDeclaration(parsed parser.Declaration) Declaration {
 	if parsed.ShortCircuit != nil {
 		panic("unexpected short circuit in convertDeclaration")
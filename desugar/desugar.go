@@ -40,6 +40,7 @@ func desugarFileTopLevel(parsed parser.FileTopLevel) (parser.FileTopLevel, error
 			},
 			func(topLevelDeclaration parser.Struct) {},
 			func(topLevelDeclaration parser.TypeAlias) {},
+			func(topLevelDeclaration parser.External) {},
 		)
 	}
 	return parsed, err
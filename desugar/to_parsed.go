@@ -1,6 +1,9 @@
 package desugar
 
-import "github.com/xplosunn/tenecs/parser"
+import (
+	"github.com/xplosunn/tenecs/parser"
+	"strconv"
+)
 
 func ToParsed(desugared FileTopLevel) parser.FileTopLevel {
 	return parser.FileTopLevel{
@@ -70,10 +73,24 @@ func toParsedTopLevelDeclaration(desugared TopLevelDeclaration) parser.TopLevelD
 		func(desugared TypeAlias) {
 			result = toParsedTypeAlias(desugared)
 		},
+		func(desugared External) {
+			result = toParsedExternal(desugared)
+		},
 	)
 	return result
 }
 
+func toParsedExternal(desugared External) parser.External {
+	return parser.External{
+		Node:   desugared.Node,
+		Name:   toParsedName(desugared.Name),
+		Type:   toParsedTypeAnnotation(desugared.Type),
+		Target: toParsedName(desugared.Target),
+		Module: strconv.Quote(desugared.Module),
+		Symbol: strconv.Quote(desugared.Symbol),
+	}
+}
+
 func toParsedDeclaration(desugared Declaration) parser.Declaration {
 	return parser.Declaration{
 		Name:           toParsedName(desugared.Name),
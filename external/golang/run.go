@@ -64,6 +64,10 @@ func RunCodeUnlessCached(t *testing.T, code string) string {
 }
 
 func RunCodeBlockingAndReturningOutputWhenFinished(code string) (string, error) {
+	return RunCodeWithEnvBlockingAndReturningOutputWhenFinished(code, nil)
+}
+
+func RunCodeWithEnvBlockingAndReturningOutputWhenFinished(code string, env []string) (string, error) {
 	dir, err := os.MkdirTemp("", "")
 	if err != nil {
 		return "", err
@@ -89,6 +93,7 @@ func RunCodeBlockingAndReturningOutputWhenFinished(code string) (string, error)
 
 	runCmd := exec.Command("./main")
 	runCmd.Dir = dir
+	runCmd.Env = append(os.Environ(), env...)
 	output, err := runCmd.CombinedOutput()
 	if err != nil {
 		return "", errors.New("error running " + generatedFilePath + ": " + err.Error())
@@ -802,3 +802,20 @@ usage := (): Void => {
 	formatted := formatter.DisplayFileTopLevel(*parsed)
 	assert.Equal(t, code, formatted)
 }
+
+func TestDisplayExternal(t *testing.T) {
+	code := `
+package main
+
+external   reverse : ( s : String ) ~> String = go ( "example.com/strutil" , "Reverse" )
+`
+	parsed, err := parser.ParseString(code)
+	assert.NoError(t, err)
+	formatted := formatter.DisplayFileTopLevel(*parsed)
+	expected := `package main
+
+
+external reverse: (s: String) ~> String = go("example.com/strutil", "Reverse")
+`
+	assert.Equal(t, expected, formatted)
+}
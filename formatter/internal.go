@@ -170,10 +170,19 @@ func displayTopLevelDeclaration(topLevelDec parser.TopLevelDeclaration, tokens [
 			tokens = t
 			result += r
 		},
+		func(topLevelDeclaration parser.External) {
+			result, tokens = displayRemainingCommentsBeforeNode(topLevelDeclaration.Name.Node, tokens, ignoreComments)
+			result += displayExternal(topLevelDeclaration)
+		},
 	)
 	return result, tokens
 }
 
+func displayExternal(external parser.External) string {
+	name, typ, target, module, symbol := parser.ExternalFields(external)
+	return fmt.Sprintf("external %s: %s = %s(%s, %s)", name.String, displayTypeAnnotation(typ), target.String, module, symbol)
+}
+
 func displayTypeAlias(typeAlias parser.TypeAlias, tokens []lexer.Token, ignoreComments bool) (string, []lexer.Token) {
 	name, generics, typ := parser.TypeAliasFields(typeAlias)
 	result := "typealias " + name.String
@@ -469,6 +478,7 @@ func displayLiteralExpression(expression parser.LiteralExpression) string {
 		func(literal float64) { result = fmt.Sprintf("%f", literal) },
 		func(literal int) { result = fmt.Sprintf("%d", literal) },
 		func(literal string) { result = literal },
+		func(literal string) { result = literal },
 		func(literal bool) { result = strconv.FormatBool(literal) },
 		func() { result = "null" },
 	)
@@ -8,11 +8,13 @@ require (
 	github.com/alecthomas/assert/v2 v2.2.1
 	github.com/alecthomas/participle/v2 v2.0.0-beta.5
 	github.com/benbjohnson/immutable v0.4.0
+	github.com/fsamin/go-dump v1.8.0
+	github.com/spf13/cobra v1.7.0
+	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
 )
 
 require (
 	github.com/alecthomas/repr v0.2.0 // indirect
-	github.com/fsamin/go-dump v1.8.0 // indirect
 	github.com/gkampitakis/ciinfo v0.3.1 // indirect
 	github.com/gkampitakis/go-diff v1.3.2 // indirect
 	github.com/gkampitakis/go-snaps v0.5.11 // indirect
@@ -23,12 +25,10 @@ require (
 	github.com/kr/text v0.2.0 // indirect
 	github.com/maruel/natural v1.1.1 // indirect
 	github.com/rogpeppe/go-internal v1.13.1 // indirect
-	github.com/spf13/cobra v1.7.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/tidwall/gjson v1.18.0 // indirect
 	github.com/tidwall/match v1.1.1 // indirect
 	github.com/tidwall/pretty v1.2.1 // indirect
 	github.com/tidwall/sjson v1.2.5 // indirect
-	golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf // indirect
 	golang.org/x/text v0.7.0 // indirect
 )
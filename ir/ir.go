@@ -126,6 +126,8 @@ func expressionToIR(ctx context, expression ast.Expression) Statement {
 				literalType = "Int"
 			}, func(literal string) {
 				literalType = "String"
+			}, func(literal string) {
+				literalType = "Char"
 			}, func(literal bool) {
 				literalType = "Boolean"
 			}, func() {
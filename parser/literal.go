@@ -4,7 +4,7 @@ import (
 	"github.com/alecthomas/participle/v2"
 )
 
-var literalUnion = participle.Union[Literal](LiteralFloat{}, LiteralInt{}, LiteralString{}, LiteralBool{}, LiteralNull{})
+var literalUnion = participle.Union[Literal](LiteralFloat{}, LiteralInt{}, LiteralString{}, LiteralChar{}, LiteralBool{}, LiteralNull{})
 
 type Literal interface {
 	sealedLiteral()
@@ -29,6 +29,13 @@ type LiteralString struct {
 
 func (literal LiteralString) sealedLiteral() {}
 
+// LiteralChar is a single unicode code point between single quotes, like 'a' or '\n'.
+type LiteralChar struct {
+	Value string `@Char`
+}
+
+func (literal LiteralChar) sealedLiteral() {}
+
 type LiteralBool struct {
 	Value bool `@"true"`
 	False bool `| @"false"`
@@ -47,6 +54,7 @@ func LiteralExhaustiveSwitch(
 	caseFloat func(literal float64),
 	caseInt func(literal int),
 	caseString func(literal string),
+	caseChar func(literal string),
 	caseBool func(literal bool),
 	caseNull func(),
 ) {
@@ -69,6 +77,11 @@ func LiteralExhaustiveSwitch(
 		caseString(litString.Value)
 		return
 	}
+	litChar, ok := literal.(LiteralChar)
+	if ok {
+		caseChar(litChar.Value)
+		return
+	}
 	litBool, ok := literal.(LiteralBool)
 	if ok {
 		caseBool(litBool.Value)
@@ -99,6 +99,7 @@ func TopLevelDeclarationExhaustiveSwitch(
 	caseDeclaration func(topLevelDeclaration Declaration),
 	caseStruct func(topLevelDeclaration Struct),
 	caseTypeAlias func(topLevelDeclaration TypeAlias),
+	caseExternal func(topLevelDeclaration External),
 ) {
 	declaration, ok := topLevelDeclaration.(Declaration)
 	if ok {
@@ -115,9 +116,14 @@ func TopLevelDeclarationExhaustiveSwitch(
 		caseTypeAlias(typeAlias)
 		return
 	}
+	external, ok := topLevelDeclaration.(External)
+	if ok {
+		caseExternal(external)
+		return
+	}
 }
 
-var topLevelDeclarationUnion = participle.Union[TopLevelDeclaration](Struct{}, TypeAlias{}, Declaration{})
+var topLevelDeclarationUnion = participle.Union[TopLevelDeclaration](Struct{}, TypeAlias{}, External{}, Declaration{})
 
 type Struct struct {
 	Name      Name             `"struct" @@`
@@ -152,6 +158,21 @@ func TypeAliasFields(typeAlias TypeAlias) (Name, []Name, TypeAnnotation) {
 	return typeAlias.Name, typeAlias.Generics, typeAlias.Type
 }
 
+type External struct {
+	Node
+	Name   Name           `"external" @@`
+	Type   TypeAnnotation `":" @@`
+	Target Name           `"=" @@`
+	Module string         `"(" @String`
+	Symbol string         `"," @String ")"`
+}
+
+func (e External) sealedTopLevelDeclaration() {}
+
+func ExternalFields(external External) (Name, TypeAnnotation, Name, string, string) {
+	return external.Name, external.Type, external.Target, external.Module, external.Symbol
+}
+
 type TypeAnnotation struct {
 	Node
 	OrTypes []TypeAnnotationElement `@@ ("|" @@)*`
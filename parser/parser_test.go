@@ -54,7 +54,7 @@ func TestParserGrammar(t *testing.T) {
 Package = "package" (Name ("." Name)*)? .
 Name = <ident> .
 Import = "import" (Name ("." Name)*)? ("as" Name)? .
-TopLevelDeclaration = Struct | TypeAlias | Declaration .
+TopLevelDeclaration = Struct | TypeAlias | External | Declaration .
 Struct = "struct" Name ("<" (Name ("," Name)*)? ">")? "(" (StructVariable ("," StructVariable)*)? ")" .
 StructVariable = Name ":" TypeAnnotation .
 TypeAnnotation = TypeAnnotationElement ("|" TypeAnnotationElement)* .
@@ -63,6 +63,7 @@ SingleNameType = Name ("<" TypeAnnotation ("," TypeAnnotation)* ">")? .
 FunctionType = ("<" Name ("," Name)* ">")? "(" (FunctionTypeArgument ("," FunctionTypeArgument)*)? ")" "~" ">" TypeAnnotation .
 FunctionTypeArgument = (Name ":")? TypeAnnotation .
 TypeAlias = "typealias" Name ("<" (Name ("," Name)*)? ">")? "=" TypeAnnotation .
+External = "external" Name ":" TypeAnnotation "=" Name "(" <string> "," <string> ")" .
 Declaration = Name ":" TypeAnnotation? DeclarationShortCircuit? "=" ExpressionBox .
 DeclarationShortCircuit = "?" TypeAnnotation? .
 ExpressionBox = Expression AccessOrInvocation* .
@@ -73,10 +74,11 @@ WhenOther = "other" Name? "=" ">" "{" ExpressionBox* "}" .
 If = "if" ExpressionBox "{" ExpressionBox* "}" ("else" IfThen)* ("else" "{" ExpressionBox* "}")? .
 IfThen = "if" ExpressionBox "{" ExpressionBox* "}" .
 LiteralExpression = Literal .
-Literal = LiteralFloat | LiteralInt | LiteralString | LiteralBool | LiteralNull .
+Literal = LiteralFloat | LiteralInt | LiteralString | LiteralChar | LiteralBool | LiteralNull .
 LiteralFloat = <float> .
 LiteralInt = "-"? <int> .
 LiteralString = <string> .
+LiteralChar = <char> .
 LiteralBool = "true" | "false" .
 LiteralNull = "null" .
 ReferenceOrInvocation = Name ArgumentsList? .
@@ -0,0 +1,199 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ManifestFileName is the name of the file marking the root directory of a Tenecs project.
+const ManifestFileName = "tenecs.json"
+
+// Manifest is the content of the project manifest, e.g.
+//
+//	{
+//	  "name": "shop",
+//	  "sourceRoots": ["src"],
+//	  "dependencies": {
+//	    "utils": "../utils"
+//	  }
+//	}
+//
+// Source roots and dependency paths are relative to the directory of the manifest.
+// When no source roots are given, the whole project directory is used.
+type Manifest struct {
+	Name         string            `json:"name"`
+	SourceRoots  []string          `json:"sourceRoots"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+type Project struct {
+	Dir          string
+	Manifest     Manifest
+	Dependencies []*Project
+}
+
+// Find looks for the manifest in the directory of path and its parents, returning nil if there is none.
+func Find(path string) (*Project, error) {
+	dir, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+	for {
+		_, err := os.Stat(filepath.Join(dir, ManifestFileName))
+		if err == nil {
+			return Load(dir)
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// Load reads the manifest in dir, along with the manifests of all its dependencies.
+func Load(dir string) (*Project, error) {
+	return load(dir, []string{}, map[string]*Project{})
+}
+
+func load(dir string, loading []string, loaded map[string]*Project) (*Project, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if project, ok := loaded[dir]; ok {
+		return project, nil
+	}
+	manifest, err := readManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	for i, loadingDir := range loading {
+		if loadingDir == dir {
+			cycle := ""
+			for _, cycleDir := range append(loading[i:], dir) {
+				cycle += " -> " + cycleDir
+			}
+			return nil, errors.New("cyclic project dependencies:" + strings.TrimPrefix(cycle, " ->"))
+		}
+	}
+
+	project := &Project{
+		Dir:          dir,
+		Manifest:     manifest,
+		Dependencies: []*Project{},
+	}
+	dependencyNames := []string{}
+	for name, _ := range manifest.Dependencies {
+		dependencyNames = append(dependencyNames, name)
+	}
+	sort.Strings(dependencyNames)
+	for _, name := range dependencyNames {
+		dependencyDir := filepath.Join(dir, manifest.Dependencies[name])
+		dependency, err := load(dependencyDir, append(loading, dir), loaded)
+		if err != nil {
+			return nil, err
+		}
+		if dependency.Manifest.Name != name {
+			return nil, fmt.Errorf("dependency %s of %s points to the project %s", name, manifest.Name, dependency.Manifest.Name)
+		}
+		project.Dependencies = append(project.Dependencies, dependency)
+	}
+	loaded[dir] = project
+	return project, nil
+}
+
+func readManifest(dir string) (Manifest, error) {
+	manifest := Manifest{}
+	bytes, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return manifest, err
+	}
+	err = json.Unmarshal(bytes, &manifest)
+	if err != nil {
+		return manifest, fmt.Errorf("invalid %s: %w", filepath.Join(dir, ManifestFileName), err)
+	}
+	if manifest.Name == "" {
+		return manifest, errors.New("missing name in " + filepath.Join(dir, ManifestFileName))
+	}
+	if len(manifest.SourceRoots) == 0 {
+		manifest.SourceRoots = []string{"."}
+	}
+	return manifest, nil
+}
+
+// SourceFiles lists the .10x files in the source roots of the project.
+func (project *Project) SourceFiles() ([]string, error) {
+	files := []string{}
+	for _, sourceRoot := range project.Manifest.SourceRoots {
+		err := filepath.Walk(filepath.Join(project.Dir, sourceRoot), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && strings.HasSuffix(path, ".10x") {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+	return removeDuplicates(files), nil
+}
+
+// AllSourceFiles lists the .10x files of the project and of all its dependencies.
+func (project *Project) AllSourceFiles() ([]string, error) {
+	files := []string{}
+	visited := map[string]bool{}
+	var visit func(project *Project) error
+	visit = func(project *Project) error {
+		if visited[project.Dir] {
+			return nil
+		}
+		visited[project.Dir] = true
+		projectFiles, err := project.SourceFiles()
+		if err != nil {
+			return err
+		}
+		files = append(files, projectFiles...)
+		for _, dependency := range project.Dependencies {
+			err = visit(dependency)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err := visit(project)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return removeDuplicates(files), nil
+}
+
+func removeDuplicates(sorted []string) []string {
+	result := []string{}
+	for i, s := range sorted {
+		if i == 0 || sorted[i-1] != s {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package project_test
+
+import (
+	"github.com/alecthomas/assert/v2"
+	"github.com/xplosunn/tenecs/project"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, path string, content string) {
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	assert.NoError(t, err)
+	err = os.WriteFile(path, []byte(content), 0644)
+	assert.NoError(t, err)
+}
+
+func TestFindProjectWithDependencies(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "app", project.ManifestFileName), `{
+  "name": "app",
+  "sourceRoots": ["src"],
+  "dependencies": {"utils": "../utils"}
+}`)
+	writeFile(t, filepath.Join(dir, "app", "src", "main.10x"), "package app\n")
+	writeFile(t, filepath.Join(dir, "app", "scripts", "ignored.10x"), "package scripts\n")
+	writeFile(t, filepath.Join(dir, "utils", project.ManifestFileName), `{"name": "utils"}`)
+	writeFile(t, filepath.Join(dir, "utils", "text", "text.10x"), "package text\n")
+
+	proj, err := project.Find(filepath.Join(dir, "app", "src", "main.10x"))
+	assert.NoError(t, err)
+	assert.Equal(t, "app", proj.Manifest.Name)
+	assert.Equal(t, 1, len(proj.Dependencies))
+	assert.Equal(t, "utils", proj.Dependencies[0].Manifest.Name)
+	assert.Equal(t, []string{"."}, proj.Dependencies[0].Manifest.SourceRoots)
+
+	files, err := proj.SourceFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "app", "src", "main.10x")}, files)
+
+	allFiles, err := proj.AllSourceFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "app", "src", "main.10x"),
+		filepath.Join(dir, "utils", "text", "text.10x"),
+	}, allFiles)
+}
+
+func TestFindWithoutProject(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "main.10x"), "package main\n")
+
+	proj, err := project.Find(filepath.Join(dir, "main.10x"))
+	assert.NoError(t, err)
+	assert.Zero(t, proj)
+}
+
+func TestLoadCyclicDependencies(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a", project.ManifestFileName), `{"name": "a", "dependencies": {"b": "../b"}}`)
+	writeFile(t, filepath.Join(dir, "b", project.ManifestFileName), `{"name": "b", "dependencies": {"a": "../a"}}`)
+
+	_, err := project.Load(filepath.Join(dir, "a"))
+	assert.EqualError(t, err, "cyclic project dependencies: "+filepath.Join(dir, "a")+" -> "+filepath.Join(dir, "b")+" -> "+filepath.Join(dir, "a"))
+}
+
+func TestLoadDependencyWithWrongName(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a", project.ManifestFileName), `{"name": "a", "dependencies": {"utils": "../b"}}`)
+	writeFile(t, filepath.Join(dir, "b", project.ManifestFileName), `{"name": "b"}`)
+
+	_, err := project.Load(filepath.Join(dir, "a"))
+	assert.EqualError(t, err, "dependency utils of a points to the project b")
+}
+
+func TestLoadManifestWithoutName(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, project.ManifestFileName), `{"sourceRoots": ["src"]}`)
+
+	_, err := project.Load(dir)
+	assert.EqualError(t, err, "missing name in "+filepath.Join(dir, project.ManifestFileName))
+}
@@ -14,6 +14,17 @@ type Program struct {
 	NativeFunctions               map[Ref]*types.Function
 	FieldsByType                  map[Ref]map[string]types.VariableType
 	StructTypeArgumentMatchFields map[Ref][]string
+	ExternalFunctions             map[Ref]ExternalFunction
+}
+
+// ExternalFunction is a function declared with `external`, whose implementation lives outside of Tenecs.
+// The typer trusts its signature, so the codegen is responsible for converting values on the way in and out.
+type ExternalFunction struct {
+	CodePoint    CodePoint
+	VariableType *types.Function
+	Target       string
+	Module       string
+	Symbol       string
 }
 
 type TypeAlias struct {
@@ -35,6 +46,7 @@ func SortRefs(refs []Ref) {
 type CodePoint struct {
 	FileName string
 	Line     int
+	Column   int
 }
 
 type Expression interface {
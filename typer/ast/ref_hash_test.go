@@ -57,8 +57,8 @@ factorialOtherImpl := (i: Int): Int => {
 
 	refHashes, err := ast.DetermineRefHashes(ast.EmptyCodePoints(*typed))
 	assert.NoError(t, err)
-	hash := "OndE4TlDosqW_ce-p21q4zN2MGM="
-	otherHash := "Mrg7aN_1nOyGS032vC6KYtjABOc="
+	hash := "-uBGGv1LRMdV8tFpvdS6UwcZw3w="
+	otherHash := "WjNQZX_f9n1-Onjl9Jo4SU7G8Z0="
 	assert.Equal(t, hash, refHashes[ast.Ref{
 		Package: "main",
 		Name:    "factorial",
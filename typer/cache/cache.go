@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+
+	"github.com/xplosunn/tenecs/parser"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"github.com/xplosunn/tenecs/typer/types"
+)
+
+func init() {
+	gob.Register(ast.Literal{})
+	gob.Register(ast.Reference{})
+	gob.Register(ast.Access{})
+	gob.Register(ast.Invocation{})
+	gob.Register(ast.Function{})
+	gob.Register(ast.Declaration{})
+	gob.Register(ast.If{})
+	gob.Register(ast.List{})
+	gob.Register(ast.When{})
+	gob.Register(parser.LiteralFloat{})
+	gob.Register(parser.LiteralInt{})
+	gob.Register(parser.LiteralString{})
+	gob.Register(parser.LiteralChar{})
+	gob.Register(parser.LiteralBool{})
+	gob.Register(parser.LiteralNull{})
+	gob.Register(&types.TypeArgument{})
+	gob.Register(&types.List{})
+	gob.Register(&types.KnownType{})
+	gob.Register(&types.Function{})
+	gob.Register(&types.OrVariableType{})
+}
+
+// DirCache is a typer.PackageCache storing the program of each package in a file of a directory.
+type DirCache struct {
+	dir string
+}
+
+func NewDirCache(dir string) (*DirCache, error) {
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	return &DirCache{dir: dir}, nil
+}
+
+// DefaultDir is a directory in the user cache specific to the version of tenecs that's running,
+// so that the cached packages of a different version of the compiler are never used.
+func DefaultDir() (string, error) {
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	version, err := compilerVersion()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(version))
+	return filepath.Join(userCacheDir, "tenecs", "typecheck", hex.EncodeToString(hash[:])[:16]), nil
+}
+
+// compilerVersion is the module version tenecs was built at, or else the commit of the checkout it was built from.
+// Builds of a modified checkout have no version, since the same commit can then be different compilers.
+func compilerVersion() (string, error) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "", errors.New("no build info")
+	}
+	if info.Main.Version != "" && info.Main.Version != "(devel)" && !strings.HasSuffix(info.Main.Version, "+dirty") {
+		return info.Main.Version, nil
+	}
+	revision := ""
+	modified := ""
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value
+		}
+	}
+	if revision == "" || modified != "false" {
+		return "", errors.New("no version for a build of a modified or unknown checkout")
+	}
+	return revision, nil
+}
+
+func (c *DirCache) Get(key string) (*ast.Program, bool) {
+	file, err := os.Open(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	defer file.Close()
+	program := ast.Program{}
+	err = gob.NewDecoder(file).Decode(&program)
+	if err != nil {
+		return nil, false
+	}
+	return &program, true
+}
+
+func (c *DirCache) Put(key string, program *ast.Program) {
+	file, err := os.CreateTemp(c.dir, key+"-*.tmp")
+	if err != nil {
+		return
+	}
+	err = gob.NewEncoder(file).Encode(program)
+	closeErr := file.Close()
+	if err != nil || closeErr != nil {
+		os.Remove(file.Name())
+		return
+	}
+	err = os.Rename(file.Name(), c.path(key))
+	if err != nil {
+		os.Remove(file.Name())
+	}
+}
+
+func (c *DirCache) path(key string) string {
+	return filepath.Join(c.dir, key+".gob")
+}
@@ -0,0 +1,150 @@
+package cache_test
+
+import (
+	"github.com/alecthomas/assert/v2"
+	"github.com/xplosunn/tenecs/codegen/codegen_golang"
+	"github.com/xplosunn/tenecs/desugar"
+	"github.com/xplosunn/tenecs/parser"
+	"github.com/xplosunn/tenecs/testcode"
+	"github.com/xplosunn/tenecs/typer"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"github.com/xplosunn/tenecs/typer/cache"
+	"testing"
+)
+
+type countingCache struct {
+	underlying typer.PackageCache
+	hits       int
+	puts       int
+}
+
+func (c *countingCache) Get(key string) (*ast.Program, bool) {
+	program, ok := c.underlying.Get(key)
+	if ok {
+		c.hits += 1
+	}
+	return program, ok
+}
+
+func (c *countingCache) Put(key string, program *ast.Program) {
+	c.puts += 1
+	c.underlying.Put(key, program)
+}
+
+func desugarAll(t *testing.T, sources map[string]string) map[string]desugar.FileTopLevel {
+	result := map[string]desugar.FileTopLevel{}
+	for file, source := range sources {
+		parsed, err := parser.ParseString(source)
+		assert.NoError(t, err)
+		desugared, err := desugar.Desugar(*parsed)
+		assert.NoError(t, err)
+		result[file] = desugared
+	}
+	return result
+}
+
+func TestCachedProgramGeneratesSameCode(t *testing.T) {
+	for _, code := range testcode.GetAll() {
+		t.Run(code.Name, func(t *testing.T) {
+			dirCache, err := cache.NewDirCache(t.TempDir())
+			assert.NoError(t, err)
+
+			sources := map[string]string{"file.10x": code.Content}
+			desugared := desugarAll(t, sources)
+
+			fresh, err := typer.TypecheckPackages(desugared)
+			assert.NoError(t, err)
+
+			counting := &countingCache{underlying: dirCache}
+			_, err = typer.TypecheckPackagesWithCache(desugared, sources, counting)
+			assert.NoError(t, err)
+			cached, err := typer.TypecheckPackagesWithCache(desugared, sources, counting)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, counting.hits)
+			assert.Equal(t, 1, counting.puts)
+
+			assert.Equal(t, codegen_golang.GenerateProgramNonRunnable(fresh), codegen_golang.GenerateProgramNonRunnable(cached))
+		})
+	}
+}
+
+func TestChangedDependencyInvalidatesDependents(t *testing.T) {
+	dirCache, err := cache.NewDirCache(t.TempDir())
+	assert.NoError(t, err)
+	counting := &countingCache{underlying: dirCache}
+
+	sources := map[string]string{
+		"colors.10x": `package colors
+
+struct Red()
+`,
+		"shapes.10x": `package shapes
+
+import colors.Red
+
+struct Square(color: Red)
+`,
+		"main.10x": `package main
+
+new := (): String => {
+  "unrelated"
+}
+`,
+	}
+	_, err = typer.TypecheckPackagesWithCache(desugarAll(t, sources), sources, counting)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, counting.hits)
+	assert.Equal(t, 3, counting.puts)
+
+	sources["colors.10x"] = `package colors
+
+struct Red(shade: String)
+`
+	_, err = typer.TypecheckPackagesWithCache(desugarAll(t, sources), sources, counting)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, counting.hits)
+	assert.Equal(t, 5, counting.puts)
+}
+
+func TestCachedPackageIsNotTypecheckedAgain(t *testing.T) {
+	dirCache, err := cache.NewDirCache(t.TempDir())
+	assert.NoError(t, err)
+	counting := &countingCache{underlying: dirCache}
+
+	sources := map[string]string{
+		"colors.10x": `package colors
+
+struct Red()
+`,
+		"main.10x": `package main
+
+import colors.Red
+
+red := (): Red => {
+  Red()
+}
+`,
+	}
+	_, err = typer.TypecheckPackagesWithCache(desugarAll(t, sources), sources, counting)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, counting.puts)
+
+	// the parsed colors package doesn't typecheck, so it's only accepted if it's taken from the cache as it was
+	parsed := desugarAll(t, map[string]string{
+		"colors.10x": `package colors
+
+wrong := (): String => {
+  1
+}
+`,
+		"main.10x": sources["main.10x"],
+	})
+	program, err := typer.TypecheckPackagesWithCache(parsed, sources, counting)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, counting.hits)
+	assert.Equal(t, 2, counting.puts)
+	_, ok := program.StructFunctions[ast.Ref{Package: "colors", Name: "Red"}]
+	assert.True(t, ok)
+	_, ok = program.Declarations[ast.Ref{Package: "colors", Name: "wrong"}]
+	assert.False(t, ok)
+}
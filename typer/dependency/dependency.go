@@ -2,6 +2,7 @@ package dependency
 
 import (
 	"github.com/xplosunn/tenecs/desugar"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -46,3 +47,40 @@ func DependenciesOfSinglePackage(parsedPackage map[string]desugar.FileTopLevel)
 	}
 	return nonStandardLibrabryDependencies
 }
+
+// FindCycle returns a cycle in the packages' dependencies, starting and ending in the same package,
+// or nil if there are none.
+func FindCycle(dependenciesByPackage map[string][]string) []string {
+	packages := maps.Keys(dependenciesByPackage)
+	slices.Sort(packages)
+
+	visited := map[string]bool{}
+	var visit func(pkg string, path []string) []string
+	visit = func(pkg string, path []string) []string {
+		for i, inPath := range path {
+			if inPath == pkg {
+				return append(append([]string{}, path[i:]...), pkg)
+			}
+		}
+		if visited[pkg] {
+			return nil
+		}
+		visited[pkg] = true
+		dependencies := append([]string{}, dependenciesByPackage[pkg]...)
+		slices.Sort(dependencies)
+		for _, dependency := range dependencies {
+			cycle := visit(dependency, append(path, pkg))
+			if cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	for _, pkg := range packages {
+		cycle := visit(pkg, []string{})
+		if cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
@@ -1,14 +1,20 @@
 package expect_type
 
 import (
+	"regexp"
+	"sort"
+	"strconv"
+
 	"github.com/xplosunn/tenecs/desugar"
 	"github.com/xplosunn/tenecs/parser"
 	"github.com/xplosunn/tenecs/typer/ast"
 	"github.com/xplosunn/tenecs/typer/binding"
 	"github.com/xplosunn/tenecs/typer/scopecheck"
+	"github.com/xplosunn/tenecs/typer/standard_library"
 	"github.com/xplosunn/tenecs/typer/type_error"
 	"github.com/xplosunn/tenecs/typer/type_of"
 	"github.com/xplosunn/tenecs/typer/types"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
@@ -665,6 +671,18 @@ func expectTypeOfReferenceOrInvocation(expectedType types.VariableType, expressi
 		}
 
 		pkg, name := binding.GetPackageLevelAndUnaliasedNameOfVariable(scope, file, expression.Var)
+		if pkg != nil && *pkg == "tenecs.regex" && name == "compile" {
+			err := expectSupportedRegexLiteral(arguments[0], expression.Arguments.Arguments[0].Node, file)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if pkg != nil && *pkg == "tenecs.json" && name == "jsonConverter" {
+			err := expectDerivableJsonType(generics[0], map[string]bool{}, expression.Var.Node, file, scope)
+			if err != nil {
+				return nil, err
+			}
+		}
 		astExp := ast.Invocation{
 			CodePoint:    codePoint(file, expression.Var.Node),
 			VariableType: overFunction.ReturnType,
@@ -696,6 +714,128 @@ func expectTypeOfReferenceOrInvocation(expectedType types.VariableType, expressi
 	}
 }
 
+// expectSupportedRegexLiteral rejects literal patterns that tenecs.regex.compile would fail on when running.
+func expectSupportedRegexLiteral(pattern ast.Expression, node parser.Node, file string) *type_error.TypecheckError {
+	literal, ok := pattern.(ast.Literal)
+	if !ok {
+		return nil
+	}
+	literalString, ok := literal.Literal.(parser.LiteralString)
+	if !ok {
+		return nil
+	}
+	unquoted, err := strconv.Unquote(literalString.Value)
+	if err != nil {
+		return nil
+	}
+	unsupported := standard_library.RegexUnsupportedSyntax(unquoted)
+	if unsupported != "" {
+		return type_error.PtrOnNodef(file, node, "Regex pattern uses %s, which isn't supported", unsupported)
+	}
+	_, err = regexp.Compile(unquoted)
+	if err != nil {
+		return type_error.PtrOnNodef(file, node, "Invalid regex pattern: %s", err.Error())
+	}
+	return nil
+}
+
+// expectDerivableJsonType rejects the types tenecs.json.jsonConverter can't derive a converter for,
+// which are type arguments and functions, wherever they appear within varType,
+// and or-types whose alternatives can't be told apart by the json they're read from.
+func expectDerivableJsonType(varType types.VariableType, visited map[string]bool, node parser.Node, file string, scope binding.Scope) *type_error.TypecheckError {
+	name := types.PrintableName(varType)
+	if visited[name] {
+		return nil
+	}
+	visited[name] = true
+	failure := func() *type_error.TypecheckError {
+		return type_error.PtrOnNodef(file, node, "jsonConverter can't derive a converter for %s", name)
+	}
+	caseTypeArgument, caseList, caseKnownType, caseFunction, caseOr := varType.VariableTypeCases()
+	if caseTypeArgument != nil {
+		return failure()
+	} else if caseList != nil {
+		return expectDerivableJsonType(caseList.Generic, visited, node, file, scope)
+	} else if caseKnownType != nil {
+		if caseKnownType.Package == "" {
+			if !slices.Contains([]string{"String", "Int", "Float", "Boolean", "Void"}, caseKnownType.Name) {
+				return failure()
+			}
+			return nil
+		}
+		if standard_library.IsOpaque(caseKnownType) {
+			return failure()
+		}
+		fields, resolutionErr := binding.GetFields(scope, caseKnownType)
+		if resolutionErr != nil {
+			return type_error.FromResolutionError(file, node, resolutionErr)
+		}
+		fieldNames := maps.Keys(fields)
+		sort.Strings(fieldNames)
+		for _, fieldName := range fieldNames {
+			err := expectDerivableJsonType(fields[fieldName], visited, node, file, scope)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	} else if caseFunction != nil {
+		return failure()
+	} else if caseOr != nil {
+		for _, element := range caseOr.Elements {
+			err := expectDerivableJsonType(element, visited, node, file, scope)
+			if err != nil {
+				return err
+			}
+		}
+		elements := caseOr.Elements
+		if flattened, ok := types.FlattenOr(caseOr).(*types.OrVariableType); ok {
+			elements = flattened.Elements
+		}
+		elementOfKind := map[string]string{}
+		elementOfTag := map[string]string{}
+		for _, element := range elements {
+			elementName := types.PrintableName(element)
+			kind, tag := derivedJsonKind(element)
+			if kind == "object" {
+				if other, ok := elementOfTag[tag]; ok {
+					return type_error.PtrOnNodef(file, node, "jsonConverter can't derive a converter for %s since %s and %s would both be tagged %s", name, other, elementName, strconv.Quote(tag))
+				}
+				elementOfTag[tag] = elementName
+				continue
+			}
+			if other, ok := elementOfKind[kind]; ok {
+				return type_error.PtrOnNodef(file, node, "jsonConverter can't derive a converter for %s since %s and %s would both be read from a json %s", name, other, elementName, kind)
+			}
+			elementOfKind[kind] = elementName
+		}
+		return nil
+	} else {
+		panic("cases on varType")
+	}
+}
+
+// derivedJsonKind is the kind of json a derived converter reads varType from,
+// along with the "$type" a struct is tagged with when it's one of several struct alternatives of an or-type.
+func derivedJsonKind(varType types.VariableType) (string, string) {
+	_, caseList, caseKnownType, _, _ := varType.VariableTypeCases()
+	if caseList != nil {
+		return "array", ""
+	} else if caseKnownType != nil && caseKnownType.Package == "" {
+		return map[string]string{
+			"String":  "string",
+			"Int":     "number",
+			"Float":   "number",
+			"Boolean": "boolean",
+			"Void":    "null",
+		}[caseKnownType.Name], ""
+	} else if caseKnownType != nil {
+		return "object", caseKnownType.Name
+	} else {
+		panic("cases on varType")
+	}
+}
+
 func expectTypeOfLiteral(expectedType types.VariableType, expression desugar.LiteralExpression, file string, scope binding.Scope) (ast.Expression, *type_error.TypecheckError) {
 	varType, err := type_of.TypeOfExpression(expression, file, scope)
 	if err != nil {
@@ -719,5 +859,6 @@ func codePoint(fileName string, node parser.Node) ast.CodePoint {
 	return ast.CodePoint{
 		FileName: fileName,
 		Line:     node.Pos.Line,
+		Column:   node.Pos.Column,
 	}
 }
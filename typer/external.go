@@ -0,0 +1,108 @@
+package typer
+
+import (
+	"github.com/xplosunn/tenecs/desugar"
+	"github.com/xplosunn/tenecs/typer/ast"
+	"github.com/xplosunn/tenecs/typer/binding"
+	"github.com/xplosunn/tenecs/typer/scopecheck"
+	"github.com/xplosunn/tenecs/typer/type_error"
+	"github.com/xplosunn/tenecs/typer/types"
+)
+
+var externalTargets = []string{"go", "js"}
+
+func validateExternals(externalsPerFile map[string][]desugar.External, pkgName string, scope binding.Scope) (map[ast.Ref]ast.ExternalFunction, binding.Scope, *type_error.TypecheckError) {
+	externals := map[ast.Ref]ast.ExternalFunction{}
+	for file, externalsInFile := range externalsPerFile {
+		for _, node := range externalsInFile {
+			targetIsKnown := false
+			for _, target := range externalTargets {
+				if node.Target.String == target {
+					targetIsKnown = true
+				}
+			}
+			if !targetIsKnown {
+				return nil, nil, type_error.PtrOnNodef(file, node.Target.Node, "unknown external target %s (expected one of %v)", node.Target.String, externalTargets)
+			}
+			varType, err := scopecheck.ValidateTypeAnnotationInScope(node.Type, file, scope)
+			if err != nil {
+				return nil, nil, type_error.FromScopeCheckError(file, err)
+			}
+			function, ok := varType.(*types.Function)
+			if !ok {
+				return nil, nil, type_error.PtrOnNodef(file, node.Type.Node, "external declarations need to be functions")
+			}
+			if len(function.Generics) > 0 {
+				return nil, nil, type_error.PtrOnNodef(file, node.Type.Node, "external functions can't have generics")
+			}
+			for _, argument := range function.Arguments {
+				if !isExternalValueType(argument.VariableType) {
+					return nil, nil, type_error.PtrOnNodef(file, node.Type.Node, "external function argument %s has unsupported type %s", argument.Name, types.PrintableName(argument.VariableType))
+				}
+			}
+			if !isExternalReturnType(function.ReturnType) {
+				return nil, nil, type_error.PtrOnNodef(file, node.Type.Node, "external function has unsupported return type %s", types.PrintableName(function.ReturnType))
+			}
+
+			var resolutionErr *binding.ResolutionError
+			scope, resolutionErr = binding.CopyAddingPackageVariable(scope, pkgName, node.Name, function)
+			if resolutionErr != nil {
+				return nil, nil, type_error.FromResolutionError(file, node.Name.Node, resolutionErr)
+			}
+			externals[ast.Ref{
+				Package: pkgName,
+				Name:    node.Name.String,
+			}] = ast.ExternalFunction{
+				CodePoint: ast.CodePoint{
+					FileName: file,
+					Line:     node.Node.Pos.Line,
+					Column:   node.Node.Pos.Column,
+				},
+				VariableType: function,
+				Target:       node.Target.String,
+				Module:       node.Module,
+				Symbol:       node.Symbol,
+			}
+		}
+	}
+	return externals, scope, nil
+}
+
+// isExternalValueType is true for the types that have an obvious counterpart in the target language:
+// String, Int, Float, Boolean and Lists of those.
+func isExternalValueType(varType types.VariableType) bool {
+	_, caseList, caseKnownType, _, _ := varType.VariableTypeCases()
+	if caseList != nil {
+		return isExternalValueType(caseList.Generic)
+	}
+	if caseKnownType != nil && caseKnownType.Package == "" {
+		switch caseKnownType.Name {
+		case "String", "Int", "Float", "Boolean":
+			return true
+		}
+	}
+	return false
+}
+
+// isExternalReturnType additionally allows Void and `X | Error`, for functions that can fail.
+func isExternalReturnType(varType types.VariableType) bool {
+	if types.VariableTypeEq(varType, types.Void()) || isExternalValueType(varType) {
+		return true
+	}
+	_, _, _, _, caseOr := varType.VariableTypeCases()
+	if caseOr == nil || len(caseOr.Elements) != 2 {
+		return false
+	}
+	value := caseOr.Elements[0]
+	if isExternalError(value) {
+		value = caseOr.Elements[1]
+	} else if !isExternalError(caseOr.Elements[1]) {
+		return false
+	}
+	return types.VariableTypeEq(value, types.Void()) || isExternalValueType(value)
+}
+
+func isExternalError(varType types.VariableType) bool {
+	_, _, caseKnownType, _, _ := varType.VariableTypeCases()
+	return caseKnownType != nil && caseKnownType.Package == "tenecs.error" && caseKnownType.Name == "Error"
+}
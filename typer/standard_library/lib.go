@@ -21,6 +21,7 @@ var DefaultTypesAvailableWithoutImport = map[string]types.VariableType{
 	"Float":   types.Float(),
 	"Int":     types.Int(),
 	"Boolean": types.Boolean(),
+	"Char":    types.Char(),
 	"Void":    types.Void(),
 	"List": &types.List{
 		Generic: &types.TypeArgument{
@@ -32,13 +33,17 @@ var DefaultTypesAvailableWithoutImport = map[string]types.VariableType{
 var topLevelPackages = map[string]Package{
 	"tenecs": packageWith(
 		withPackage("list", tenecs_list),
+		withPackage("bigint", tenecs_bigint),
 		withPackage("boolean", tenecs_boolean),
+		withPackage("char", tenecs_char),
 		withPackage("compare", tenecs_compare),
 		withPackage("error", tenecs_error),
 		withPackage("int", tenecs_int),
 		withPackage("json", tenecs_json),
 		withPackage("go", tenecs_go),
+		withPackage("http", tenecs_http),
 		withPackage("ref", tenecs_ref),
+		withPackage("regex", tenecs_regex),
 		withPackage("string", tenecs_string),
 		withPackage("test", tenecs_test),
 		withPackage("time", tenecs_time),
@@ -78,7 +83,7 @@ func StdLibGetFunctionOrPanic(t *testing.T, ref string) *types.Function {
 		t.Fatal("StdLibGetOrPanic" + ref)
 	}
 	arguments := []types.FunctionArgument{}
-	for _, fieldName := range pkg.Structs[finalName].FieldNamesSorted {
+	for _, fieldName := range pkg.Structs[finalName].ConstructorFieldNames {
 		arguments = append(arguments, types.FunctionArgument{
 			Name:         fieldName,
 			VariableType: pkg.Structs[finalName].Fields[fieldName],
@@ -1,24 +1,35 @@
 package standard_library
 
-import "github.com/xplosunn/tenecs/typer/types"
+import (
+	"strings"
+
+	"github.com/xplosunn/tenecs/typer/types"
+)
 
 type Package struct {
-	Packages  map[string]Package
-	Structs   map[string]*StructWithFields
-	Variables map[string]types.VariableType
+	Packages    map[string]Package
+	Structs     map[string]*StructWithFields
+	TypeAliases map[string]types.VariableType
+	Variables   map[string]types.VariableType
 }
 
 type StructWithFields struct {
 	Struct           *types.KnownType
 	Fields           map[string]types.VariableType
 	FieldNamesSorted []string
+	// ConstructorFieldNames are the fields the constructor takes, the others start out as an empty List or as Void
+	ConstructorFieldNames []string
+	// Opaque structs can only be made and looked into by the functions of their package, so programs see neither
+	// a constructor nor fields. Their field names are only there for the codegens to keep their representation in.
+	Opaque bool
 }
 
 func packageWith(opts ...func(*Package)) Package {
 	pkg := &Package{
-		Packages:  map[string]Package{},
-		Structs:   map[string]*StructWithFields{},
-		Variables: map[string]types.VariableType{},
+		Packages:    map[string]Package{},
+		Structs:     map[string]*StructWithFields{},
+		TypeAliases: map[string]types.VariableType{},
+		Variables:   map[string]types.VariableType{},
 	}
 	for _, opt := range opts {
 		opt(pkg)
@@ -34,9 +45,10 @@ func withPackage(name string, pack Package) func(pkg *Package) {
 
 func structWithFields(name string, struc *types.KnownType, fieldFuncs ...func(*StructWithFields)) *StructWithFields {
 	result := &StructWithFields{
-		Struct:           struc,
-		Fields:           map[string]types.VariableType{},
-		FieldNamesSorted: []string{},
+		Struct:                struc,
+		Fields:                map[string]types.VariableType{},
+		FieldNamesSorted:      []string{},
+		ConstructorFieldNames: []string{},
 	}
 	for _, f := range fieldFuncs {
 		f(result)
@@ -44,19 +56,56 @@ func structWithFields(name string, struc *types.KnownType, fieldFuncs ...func(*S
 	return result
 }
 
+func opaqueStruct(name string, struc *types.KnownType, representationFieldNames ...string) *StructWithFields {
+	return &StructWithFields{
+		Struct:                struc,
+		Fields:                map[string]types.VariableType{},
+		FieldNamesSorted:      representationFieldNames,
+		ConstructorFieldNames: representationFieldNames,
+		Opaque:                true,
+	}
+}
+
+// IsOpaque is whether the type is one of the opaque structs of the standard library.
+func IsOpaque(knownType *types.KnownType) bool {
+	pkg := StdLib
+	for _, name := range strings.Split(knownType.Package, ".") {
+		nestedPkg, ok := pkg.Packages[name]
+		if !ok {
+			return false
+		}
+		pkg = nestedPkg
+	}
+	struc, ok := pkg.Structs[knownType.Name]
+	return ok && struc.Opaque
+}
+
 func withStruct(structWithFields *StructWithFields) func(pkg *Package) {
 	return func(pkg *Package) {
 		pkg.Structs[structWithFields.Struct.Name] = structWithFields
 	}
 }
 
+func withTypeAlias(name string, varType types.VariableType) func(pkg *Package) {
+	return func(pkg *Package) {
+		pkg.TypeAliases[name] = varType
+	}
+}
+
 func structField(name string, varType types.VariableType) func(*StructWithFields) {
 	return func(structWithFields *StructWithFields) {
 		structWithFields.FieldNamesSorted = append(structWithFields.FieldNamesSorted, name)
+		structWithFields.ConstructorFieldNames = append(structWithFields.ConstructorFieldNames, name)
 		structWithFields.Fields[name] = varType
 	}
 }
 
+func structConstructedWith(fieldNames ...string) func(*StructWithFields) {
+	return func(structWithFields *StructWithFields) {
+		structWithFields.ConstructorFieldNames = fieldNames
+	}
+}
+
 func withFunction(name string, function *types.Function) func(pkg *Package) {
 	return func(pkg *Package) {
 		pkg.Variables[name] = function
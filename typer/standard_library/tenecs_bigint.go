@@ -0,0 +1,66 @@
+package standard_library
+
+import "github.com/xplosunn/tenecs/typer/types"
+
+var tenecs_bigint = packageWith(
+	withStruct(Tenecs_bigint_BigInt),
+	withFunction("abs", tenecs_bigint_abs),
+	withFunction("comparator", tenecs_bigint_comparator),
+	withFunction("div", tenecs_bigint_div),
+	withFunction("fromInt", tenecs_bigint_fromInt),
+	withFunction("fromString", tenecs_bigint_fromString),
+	withFunction("greaterThan", tenecs_bigint_greaterThan),
+	withFunction("lessThan", tenecs_bigint_lessThan),
+	withFunction("minus", tenecs_bigint_minus),
+	withFunction("mod", tenecs_bigint_mod),
+	withFunction("negate", tenecs_bigint_negate),
+	withFunction("plus", tenecs_bigint_plus),
+	withFunction("pow", tenecs_bigint_pow),
+	withFunction("times", tenecs_bigint_times),
+	withFunction("toInt", tenecs_bigint_toInt),
+	withFunction("toString", tenecs_bigint_toString),
+)
+
+// BigInt can only be made with fromInt, fromString and the operations on it, which keep its digits in the same form
+// on every backend, so that values can be compared with eq.
+// The digits are in base 10, with a leading - when negative and no leading zeros.
+var Tenecs_bigint_BigInt = opaqueStruct("BigInt", tenecs_bigint_BigInt, "digits")
+
+var tenecs_bigint_BigInt = types.Struct(
+	"tenecs.bigint",
+	"BigInt",
+	nil,
+)
+
+var tenecs_bigint_fromInt = functionFromType("(value: Int) ~> BigInt", Tenecs_bigint_BigInt)
+
+// fromString accepts an optional sign followed by base 10 digits.
+var tenecs_bigint_fromString = functionFromType("(str: String) ~> BigInt | Error", Tenecs_bigint_BigInt, Tenecs_error_Error)
+
+var tenecs_bigint_toInt = functionFromType("(value: BigInt) ~> Int | Error", Tenecs_bigint_BigInt, Tenecs_error_Error)
+
+var tenecs_bigint_toString = functionFromType("(value: BigInt) ~> String", Tenecs_bigint_BigInt)
+
+var tenecs_bigint_plus = functionFromType("(a: BigInt, b: BigInt) ~> BigInt", Tenecs_bigint_BigInt)
+
+var tenecs_bigint_minus = functionFromType("(a: BigInt, b: BigInt) ~> BigInt", Tenecs_bigint_BigInt)
+
+var tenecs_bigint_times = functionFromType("(a: BigInt, b: BigInt) ~> BigInt", Tenecs_bigint_BigInt)
+
+// div rounds towards zero, like tenecs.int.div.
+var tenecs_bigint_div = functionFromType("(a: BigInt, b: BigInt) ~> BigInt | Error", Tenecs_bigint_BigInt, Tenecs_error_Error)
+
+// mod has the sign of a, like tenecs.int.mod.
+var tenecs_bigint_mod = functionFromType("(a: BigInt, b: BigInt) ~> BigInt | Error", Tenecs_bigint_BigInt, Tenecs_error_Error)
+
+var tenecs_bigint_pow = functionFromType("(base: BigInt, exponent: Int) ~> BigInt | Error", Tenecs_bigint_BigInt, Tenecs_error_Error)
+
+var tenecs_bigint_negate = functionFromType("(a: BigInt) ~> BigInt", Tenecs_bigint_BigInt)
+
+var tenecs_bigint_abs = functionFromType("(a: BigInt) ~> BigInt", Tenecs_bigint_BigInt)
+
+var tenecs_bigint_greaterThan = functionFromType("(a: BigInt, b: BigInt) ~> Boolean", Tenecs_bigint_BigInt)
+
+var tenecs_bigint_lessThan = functionFromType("(a: BigInt, b: BigInt) ~> Boolean", Tenecs_bigint_BigInt)
+
+var tenecs_bigint_comparator = functionFromType("() ~> Comparator<BigInt>", Tenecs_compare_Comparator, Tenecs_bigint_BigInt)
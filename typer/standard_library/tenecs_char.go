@@ -0,0 +1,25 @@
+package standard_library
+
+var tenecs_char = packageWith(
+	withFunction("codePoint", tenecs_char_codePoint),
+	withFunction("fromCodePoint", tenecs_char_fromCodePoint),
+	withFunction("isDigit", tenecs_char_isDigit),
+	withFunction("isLetter", tenecs_char_isLetter),
+	withFunction("isWhitespace", tenecs_char_isWhitespace),
+	withFunction("toString", tenecs_char_toString),
+)
+
+var tenecs_char_codePoint = functionFromType("(char: Char) ~> Int")
+
+var tenecs_char_fromCodePoint = functionFromType("(codePoint: Int) ~> Char | Error", Tenecs_error_Error)
+
+// isDigit is only 0 to 9, which are the digits tenecs.string.toInt accepts.
+var tenecs_char_isDigit = functionFromType("(char: Char) ~> Boolean")
+
+// isLetter is any unicode letter, not only the ascii ones.
+var tenecs_char_isLetter = functionFromType("(char: Char) ~> Boolean")
+
+// isWhitespace is any character with the unicode White_Space property.
+var tenecs_char_isWhitespace = functionFromType("(char: Char) ~> Boolean")
+
+var tenecs_char_toString = functionFromType("(char: Char) ~> String")
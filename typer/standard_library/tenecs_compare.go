@@ -1,7 +1,79 @@
 package standard_library
 
+import "github.com/xplosunn/tenecs/typer/types"
+
 var tenecs_compare = packageWith(
+	withStruct(Tenecs_compare_Comparator),
+	withStruct(Tenecs_compare_Equal),
+	withStruct(Tenecs_compare_Greater),
+	withStruct(Tenecs_compare_Less),
+	withTypeAlias("Ordering", tenecs_compare_Ordering),
+	withFunction("by", tenecs_compare_by),
+	withFunction("dateComparator", tenecs_compare_dateComparator),
 	withFunction("eq", tenecs_compare_eq),
+	withFunction("floatComparator", tenecs_compare_floatComparator),
+	withFunction("intComparator", tenecs_compare_intComparator),
+	withFunction("reverse", tenecs_compare_reverse),
+	withFunction("stringComparator", tenecs_compare_stringComparator),
+	withFunction("thenBy", tenecs_compare_thenBy),
 )
 
+var Tenecs_compare_Less = structWithFields("Less", tenecs_compare_Less)
+
+var tenecs_compare_Less = types.Struct("tenecs.compare", "Less", nil)
+
+var Tenecs_compare_Equal = structWithFields("Equal", tenecs_compare_Equal)
+
+var tenecs_compare_Equal = types.Struct("tenecs.compare", "Equal", nil)
+
+var Tenecs_compare_Greater = structWithFields("Greater", tenecs_compare_Greater)
+
+var tenecs_compare_Greater = types.Struct("tenecs.compare", "Greater", nil)
+
+// Ordering is how the first of two values compares to the second.
+var tenecs_compare_Ordering = &types.OrVariableType{
+	Elements: []types.VariableType{tenecs_compare_Less, tenecs_compare_Equal, tenecs_compare_Greater},
+}
+
+var Tenecs_compare_Comparator = structWithFields("Comparator", tenecs_compare_Comparator, tenecs_compare_Comparator_Fields...)
+
+var tenecs_compare_Comparator = types.Struct(
+	"tenecs.compare",
+	"Comparator",
+	[]string{"T"},
+)
+
+var tenecs_compare_Comparator_Fields = []func(fields *StructWithFields){
+	structField("compare", &types.Function{
+		Arguments: []types.FunctionArgument{
+			types.FunctionArgument{
+				Name:         "first",
+				VariableType: &types.TypeArgument{Name: "T"},
+			},
+			types.FunctionArgument{
+				Name:         "second",
+				VariableType: &types.TypeArgument{Name: "T"},
+			},
+		},
+		ReturnType: tenecs_compare_Ordering,
+	}),
+}
+
+// by compares values by one of their fields, or anything else derived from them.
+var tenecs_compare_by = functionFromType("<T, F>(field: (T) ~> F, comparator: Comparator<F>) ~> Comparator<T>", Tenecs_compare_Comparator)
+
+var tenecs_compare_dateComparator = functionFromType("() ~> Comparator<Date>", Tenecs_compare_Comparator, Tenecs_time_Date)
+
 var tenecs_compare_eq = functionFromType("<T>(first: T, second: T) ~> Boolean")
+
+var tenecs_compare_floatComparator = functionFromType("() ~> Comparator<Float>", Tenecs_compare_Comparator)
+
+var tenecs_compare_intComparator = functionFromType("() ~> Comparator<Int>", Tenecs_compare_Comparator)
+
+var tenecs_compare_reverse = functionFromType("<T>(comparator: Comparator<T>) ~> Comparator<T>", Tenecs_compare_Comparator)
+
+// stringComparator orders strings by their unicode code points.
+var tenecs_compare_stringComparator = functionFromType("() ~> Comparator<String>", Tenecs_compare_Comparator)
+
+// thenBy uses the second comparator for the values that the first one finds equal.
+var tenecs_compare_thenBy = functionFromType("<T>(first: Comparator<T>, second: Comparator<T>) ~> Comparator<T>", Tenecs_compare_Comparator)
@@ -4,8 +4,16 @@ import "github.com/xplosunn/tenecs/typer/types"
 
 var tenecs_error = packageWith(
 	withStruct(Tenecs_error_Error),
+	withStruct(Tenecs_error_ErrorDetail),
+	withFunction("causes", tenecs_error_causes),
+	withFunction("render", tenecs_error_render),
+	withFunction("withCode", tenecs_error_withCode),
+	withFunction("withContext", tenecs_error_withContext),
+	withFunction("withDetail", tenecs_error_withDetail),
+	withFunction("wrap", tenecs_error_wrap),
 )
 
+// Error is constructed with just the message, the other fields being set through wrap, withCode and withDetail.
 var Tenecs_error_Error = structWithFields("Error", tenecs_error_Error, tenecs_error_Error_Fields...)
 
 var tenecs_error_Error = types.Struct(
@@ -16,4 +24,39 @@ var tenecs_error_Error = types.Struct(
 
 var tenecs_error_Error_Fields = []func(fields *StructWithFields){
 	structField("message", types.String()),
+	structField("cause", &types.OrVariableType{
+		Elements: []types.VariableType{tenecs_error_Error, types.Void()},
+	}),
+	structField("code", &types.OrVariableType{
+		Elements: []types.VariableType{types.String(), types.Void()},
+	}),
+	structField("details", &types.List{Generic: &tenecs_error_ErrorDetail}),
+	structConstructedWith("message"),
 }
+
+var Tenecs_error_ErrorDetail = structWithFields("ErrorDetail", &tenecs_error_ErrorDetail, tenecs_error_ErrorDetail_Fields...)
+
+var tenecs_error_ErrorDetail = types.KnownType{
+	Package: "tenecs.error",
+	Name:    "ErrorDetail",
+}
+
+var tenecs_error_ErrorDetail_Fields = []func(fields *StructWithFields){
+	structField("key", types.String()),
+	structField("value", types.String()),
+}
+
+// causes is the error followed by its cause, the cause of that, and so on.
+var tenecs_error_causes = functionFromType("(error: Error) ~> List<Error>", Tenecs_error_Error)
+
+// render is one line per error in the chain, each with its code and details.
+var tenecs_error_render = functionFromType("(error: Error) ~> String", Tenecs_error_Error)
+
+var tenecs_error_withCode = functionFromType("(error: Error, code: String) ~> Error", Tenecs_error_Error)
+
+// withContext wraps the error, if there is one, so that it says what was being done when it happened.
+var tenecs_error_withContext = functionFromType("<T>(result: T | Error, message: String) ~> T | Error", Tenecs_error_Error)
+
+var tenecs_error_withDetail = functionFromType("(error: Error, key: String, value: String) ~> Error", Tenecs_error_Error)
+
+var tenecs_error_wrap = functionFromType("(cause: Error, message: String) ~> Error", Tenecs_error_Error)
@@ -3,12 +3,33 @@ package standard_library
 import "github.com/xplosunn/tenecs/typer/types"
 
 var tenecs_go = packageWith(
+	withStruct(Tenecs_go_Concurrent),
 	withStruct(Tenecs_go_Console),
+	withStruct(Tenecs_go_FileSystem),
+	withStruct(Tenecs_go_Http),
+	withStruct(Tenecs_go_LogField),
+	withStruct(Tenecs_go_Logger),
 	withStruct(Tenecs_go_Main),
+	withStruct(Tenecs_go_Process),
+	withStruct(Tenecs_go_Random),
 	withStruct(Tenecs_go_Runtime),
+	withStruct(Tenecs_go_Task),
 	withStruct(Tenecs_go_Time),
 )
 
+var Tenecs_go_Concurrent = structWithFields("Concurrent", &tenecs_go_Concurrent, tenecs_go_Concurrent_Fields...)
+
+var tenecs_go_Concurrent = types.KnownType{
+	Package: "tenecs.go",
+	Name:    "Concurrent",
+}
+
+var tenecs_go_Concurrent_Fields = []func(fields *StructWithFields){
+	structField("parallel", functionFromType("<T>(tasks: List<() ~> T>) ~> List<T>")),
+	structField("ref", functionFromType("<T>(value: T) ~> Ref<T>", Tenecs_ref_Ref)),
+	structField("spawn", functionFromType("<T>(task: () ~> T) ~> Task<T>", Tenecs_go_Task)),
+}
+
 var Tenecs_go_Console = structWithFields("Console", &tenecs_go_Console, tenecs_go_Console_Fields...)
 
 var tenecs_go_Console = types.KnownType{
@@ -17,6 +38,7 @@ var tenecs_go_Console = types.KnownType{
 }
 
 var tenecs_go_Console_Fields = []func(fields *StructWithFields){
+	structField("error", functionFromType("(message: String) ~> Void")),
 	structField("log", &types.Function{
 		Arguments: []types.FunctionArgument{
 			{
@@ -26,6 +48,73 @@ var tenecs_go_Console_Fields = []func(fields *StructWithFields){
 		},
 		ReturnType: types.Void(),
 	}),
+	structField("readAll", functionFromType("() ~> String")),
+	structField("readLine", functionFromType("() ~> String | Void")),
+}
+
+var Tenecs_go_FileSystem = structWithFields("FileSystem", &tenecs_go_FileSystem, tenecs_go_FileSystem_Fields...)
+
+var tenecs_go_FileSystem = types.KnownType{
+	Package: "tenecs.go",
+	Name:    "FileSystem",
+}
+
+var tenecs_go_FileSystem_Fields = []func(fields *StructWithFields){
+	structField("delete", functionFromType("(path: String) ~> Void | Error", Tenecs_error_Error)),
+	structField("exists", functionFromType("(path: String) ~> Boolean | Error", Tenecs_error_Error)),
+	structField("listDirectory", functionFromType("(path: String) ~> List<String> | Error", Tenecs_error_Error)),
+	structField("readFile", functionFromType("(path: String) ~> String | Error", Tenecs_error_Error)),
+	structField("writeFile", functionFromType("(path: String, content: String) ~> Void | Error", Tenecs_error_Error)),
+}
+
+var Tenecs_go_Http = structWithFields("Http", &tenecs_go_Http, tenecs_go_Http_Fields...)
+
+var tenecs_go_Http = types.KnownType{
+	Package: "tenecs.go",
+	Name:    "Http",
+}
+
+var tenecs_go_Http_Fields = []func(fields *StructWithFields){
+	structField("get", functionFromType("(url: String) ~> Response | Error", Tenecs_http_Response, Tenecs_error_Error)),
+	structField("post", functionFromType("(url: String, contentType: String, body: String) ~> Response | Error", Tenecs_http_Response, Tenecs_error_Error)),
+	structField("serve", functionFromType("(port: Int, handler: (Request) ~> Response) ~> Void | Error", Tenecs_http_Request, Tenecs_http_Response, Tenecs_error_Error)),
+}
+
+var Tenecs_go_LogField = structWithFields("LogField", &tenecs_go_LogField, tenecs_go_LogField_Fields...)
+
+var tenecs_go_LogField = types.KnownType{
+	Package: "tenecs.go",
+	Name:    "LogField",
+}
+
+var tenecs_go_LogField_Fields = []func(fields *StructWithFields){
+	structField("key", types.String()),
+	structField("value", &types.OrVariableType{
+		Elements: []types.VariableType{types.String(), types.Int(), types.Float(), types.Boolean()},
+	}),
+}
+
+var Tenecs_go_Logger = structWithFields("Logger", &tenecs_go_Logger, tenecs_go_Logger_Fields...)
+
+var tenecs_go_Logger = types.KnownType{
+	Package: "tenecs.go",
+	Name:    "Logger",
+}
+
+var tenecs_go_Logger_Fields = []func(fields *StructWithFields){
+	structField("debug", functionFromType("(message: String, fields: List<LogField>) ~> Void", Tenecs_go_LogField)),
+	structField("error", functionFromType("(message: String, fields: List<LogField>) ~> Void", Tenecs_go_LogField)),
+	structField("info", functionFromType("(message: String, fields: List<LogField>) ~> Void", Tenecs_go_LogField)),
+	structField("warn", functionFromType("(message: String, fields: List<LogField>) ~> Void", Tenecs_go_LogField)),
+	structField("with", &types.Function{
+		Arguments: []types.FunctionArgument{
+			{
+				Name:         "fields",
+				VariableType: &types.List{Generic: &tenecs_go_LogField},
+			},
+		},
+		ReturnType: &tenecs_go_Logger,
+	}),
 }
 
 var Tenecs_go_Main = structWithFields("Main", &tenecs_go_Main, tenecs_go_Main_Fields...)
@@ -47,6 +136,33 @@ var tenecs_go_Main_Fields = []func(fields *StructWithFields){
 	}),
 }
 
+var Tenecs_go_Process = structWithFields("Process", &tenecs_go_Process, tenecs_go_Process_Fields...)
+
+var tenecs_go_Process = types.KnownType{
+	Package: "tenecs.go",
+	Name:    "Process",
+}
+
+var tenecs_go_Process_Fields = []func(fields *StructWithFields){
+	structField("args", functionFromType("() ~> List<String>")),
+	structField("env", functionFromType("(name: String) ~> String | Void")),
+	structField("exit", functionFromType("(code: Int) ~> Void")),
+}
+
+var Tenecs_go_Random = structWithFields("Random", &tenecs_go_Random, tenecs_go_Random_Fields...)
+
+var tenecs_go_Random = types.KnownType{
+	Package: "tenecs.go",
+	Name:    "Random",
+}
+
+var tenecs_go_Random_Fields = []func(fields *StructWithFields){
+	structField("float", functionFromType("() ~> Float")),
+	structField("int", functionFromType("(from: Int, until: Int) ~> Int | Error", Tenecs_error_Error)),
+	structField("shuffle", functionFromType("<T>(list: List<T>) ~> List<T>")),
+	structField("uuid", functionFromType("() ~> String")),
+}
+
 var Tenecs_go_Runtime = structWithFields("Runtime", &tenecs_go_Runtime, tenecs_go_Runtime_Fields...)
 
 var tenecs_go_Runtime = types.KnownType{
@@ -55,11 +171,32 @@ var tenecs_go_Runtime = types.KnownType{
 }
 
 var tenecs_go_Runtime_Fields = []func(fields *StructWithFields){
+	structField("concurrent", &tenecs_go_Concurrent),
 	structField("console", &tenecs_go_Console),
+	structField("fs", &tenecs_go_FileSystem),
+	structField("http", &tenecs_go_Http),
+	structField("logger", &tenecs_go_Logger),
+	structField("process", &tenecs_go_Process),
+	structField("random", &tenecs_go_Random),
 	structField("ref", tenecs_ref_RefCreator),
 	structField("time", &tenecs_go_Time),
 }
 
+var Tenecs_go_Task = structWithFields("Task", tenecs_go_Task, tenecs_go_Task_Fields...)
+
+var tenecs_go_Task = types.Struct(
+	"tenecs.go",
+	"Task",
+	[]string{"T"},
+)
+
+var tenecs_go_Task_Fields = []func(fields *StructWithFields){
+	structField("await", &types.Function{
+		Arguments:  []types.FunctionArgument{},
+		ReturnType: &types.TypeArgument{Name: "T"},
+	}),
+}
+
 var Tenecs_go_Time = structWithFields("Time", &tenecs_go_Time, tenecs_go_Time_Fields...)
 
 var tenecs_go_Time = types.KnownType{
@@ -68,5 +205,7 @@ var tenecs_go_Time = types.KnownType{
 }
 
 var tenecs_go_Time_Fields = []func(fields *StructWithFields){
+	structField("now", functionFromType("() ~> Instant", Tenecs_time_Instant)),
+	structField("sleep", functionFromType("(duration: Duration) ~> Void", Tenecs_time_Duration)),
 	structField("today", functionFromType("() ~> Date", Tenecs_time_Date)),
 }
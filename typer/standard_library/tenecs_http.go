@@ -0,0 +1,58 @@
+package standard_library
+
+import "github.com/xplosunn/tenecs/typer/types"
+
+var tenecs_http = packageWith(
+	withStruct(Tenecs_http_Header),
+	withStruct(Tenecs_http_Request),
+	withStruct(Tenecs_http_Response),
+	withFunction("header", Tenecs_http_header),
+	withFunction("ok", Tenecs_http_ok),
+)
+
+var Tenecs_http_Header = structWithFields("Header", tenecs_http_Header, tenecs_http_Header_Fields...)
+
+var tenecs_http_Header = types.Struct(
+	"tenecs.http",
+	"Header",
+	nil,
+)
+
+var tenecs_http_Header_Fields = []func(fields *StructWithFields){
+	structField("name", types.String()),
+	structField("value", types.String()),
+}
+
+var Tenecs_http_Request = structWithFields("Request", tenecs_http_Request, tenecs_http_Request_Fields...)
+
+var tenecs_http_Request = types.Struct(
+	"tenecs.http",
+	"Request",
+	nil,
+)
+
+var tenecs_http_Request_Fields = []func(fields *StructWithFields){
+	structField("method", types.String()),
+	structField("path", types.String()),
+	structField("query", types.String()),
+	structField("headers", &types.List{Generic: tenecs_http_Header}),
+	structField("body", types.String()),
+}
+
+var Tenecs_http_Response = structWithFields("Response", tenecs_http_Response, tenecs_http_Response_Fields...)
+
+var tenecs_http_Response = types.Struct(
+	"tenecs.http",
+	"Response",
+	nil,
+)
+
+var tenecs_http_Response_Fields = []func(fields *StructWithFields){
+	structField("status", types.Int()),
+	structField("headers", &types.List{Generic: tenecs_http_Header}),
+	structField("body", types.String()),
+}
+
+var Tenecs_http_header = functionFromType("(headers: List<Header>, name: String) ~> String | Void", Tenecs_http_Header)
+
+var Tenecs_http_ok = functionFromType("(body: String) ~> Response", Tenecs_http_Response)
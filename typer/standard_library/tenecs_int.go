@@ -2,8 +2,15 @@ package standard_library
 
 import "github.com/xplosunn/tenecs/typer/types"
 
+// Int is a 64-bit signed integer on every backend. plus, minus, times, negate and abs wrap around on overflow,
+// their checked counterparts return an Error instead.
 var tenecs_int = packageWith(
 	withFunction("abs", tenecs_int_abs),
+	withFunction("checkedAbs", tenecs_int_checkedAbs),
+	withFunction("checkedMinus", tenecs_int_checkedMinus),
+	withFunction("checkedNegate", tenecs_int_checkedNegate),
+	withFunction("checkedPlus", tenecs_int_checkedPlus),
+	withFunction("checkedTimes", tenecs_int_checkedTimes),
 	withFunction("div", tenecs_int_div),
 	withFunction("greaterThan", tenecs_int_greaterThan),
 	withFunction("lessThan", tenecs_int_lessThan),
@@ -181,4 +188,14 @@ var tenecs_int_negate = &types.Function{
 		},
 	},
 	ReturnType: types.Int(),
-}
\ No newline at end of file
+}
+
+var tenecs_int_checkedPlus = functionFromType("(a: Int, b: Int) ~> Int | Error", Tenecs_error_Error)
+
+var tenecs_int_checkedMinus = functionFromType("(a: Int, b: Int) ~> Int | Error", Tenecs_error_Error)
+
+var tenecs_int_checkedTimes = functionFromType("(a: Int, b: Int) ~> Int | Error", Tenecs_error_Error)
+
+var tenecs_int_checkedNegate = functionFromType("(a: Int) ~> Int | Error", Tenecs_error_Error)
+
+var tenecs_int_checkedAbs = functionFromType("(a: Int) ~> Int | Error", Tenecs_error_Error)
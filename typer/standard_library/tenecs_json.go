@@ -6,17 +6,76 @@ import (
 )
 
 var tenecs_json = packageWith(
+	withStruct(Tenecs_json_JsonArray),
 	withStruct(Tenecs_json_JsonConverter),
+	withStruct(Tenecs_json_JsonDecodeError),
+	withStruct(Tenecs_json_JsonEntry),
 	withStruct(Tenecs_json_JsonField),
+	withStruct(Tenecs_json_JsonObject),
+	withTypeAlias("JsonValue", tenecs_json_JsonValue),
+	withFunction("decodeErrors", tenecs_json_decodeErrors),
 	withFunction("jsonList", tenecs_json_jsonList),
 	withFunction("jsonBoolean", tenecs_json_jsonBoolean),
+	withFunction("jsonConverter", tenecs_json_jsonConverter),
+	withFunction("jsonFirstError", tenecs_json_jsonFirstError),
+	withFunction("jsonFloat", tenecs_json_jsonFloat),
 	withFunction("jsonInt", tenecs_json_jsonInt),
+	withFunction("jsonNullable", tenecs_json_jsonNullable),
 	withFunction("jsonObject0", tenecs_json_jsonObject0),
 	withFunctions(tenecs_json_jsonObject),
+	withFunction("jsonOptional", tenecs_json_jsonOptional),
 	withFunction("jsonOr", tenecs_json_jsonOr),
 	withFunction("jsonString", tenecs_json_jsonString),
+	withFunction("jsonValue", tenecs_json_jsonValue),
 )
 
+// JsonValue is any json, for when there's no schema to parse it into.
+// Numbers are always Float and object entries are read sorted by key.
+var tenecs_json_JsonValue = &types.OrVariableType{
+	Elements: []types.VariableType{
+		types.String(),
+		types.Float(),
+		types.Boolean(),
+		types.Void(),
+		&tenecs_json_JsonArray,
+		&tenecs_json_JsonObject,
+	},
+}
+
+var Tenecs_json_JsonArray = structWithFields("JsonArray", &tenecs_json_JsonArray, tenecs_json_JsonArray_Fields...)
+
+var tenecs_json_JsonArray = types.KnownType{
+	Package: "tenecs.json",
+	Name:    "JsonArray",
+}
+
+var tenecs_json_JsonArray_Fields = []func(fields *StructWithFields){
+	structField("values", &types.List{Generic: tenecs_json_JsonValue}),
+}
+
+var Tenecs_json_JsonEntry = structWithFields("JsonEntry", &tenecs_json_JsonEntry, tenecs_json_JsonEntry_Fields...)
+
+var tenecs_json_JsonEntry = types.KnownType{
+	Package: "tenecs.json",
+	Name:    "JsonEntry",
+}
+
+var tenecs_json_JsonEntry_Fields = []func(fields *StructWithFields){
+	structField("key", types.String()),
+	structField("value", tenecs_json_JsonValue),
+}
+
+var Tenecs_json_JsonObject = structWithFields("JsonObject", &tenecs_json_JsonObject, tenecs_json_JsonObject_Fields...)
+
+var tenecs_json_JsonObject = types.KnownType{
+	Package: "tenecs.json",
+	Name:    "JsonObject",
+}
+
+var tenecs_json_JsonObject_Fields = []func(fields *StructWithFields){
+	structField("entries", &types.List{Generic: &tenecs_json_JsonEntry}),
+}
+
 var Tenecs_json_JsonConverter = structWithFields("JsonConverter", tenecs_json_JsonConverter, tenecs_json_FromJson_Fields...)
 
 var tenecs_json_JsonConverter = types.Struct(
@@ -59,6 +118,22 @@ var tenecs_json_FromJson_Fields = []func(fields *StructWithFields){
 	}),
 }
 
+// JsonDecodeError is one of the errors a fromJson failed with, where path is like $.users[3].email.
+// expected and actual are empty when the converter that failed isn't one of the standard library ones.
+var Tenecs_json_JsonDecodeError = structWithFields("JsonDecodeError", &tenecs_json_JsonDecodeError, tenecs_json_JsonDecodeError_Fields...)
+
+var tenecs_json_JsonDecodeError = types.KnownType{
+	Package: "tenecs.json",
+	Name:    "JsonDecodeError",
+}
+
+var tenecs_json_JsonDecodeError_Fields = []func(fields *StructWithFields){
+	structField("path", types.String()),
+	structField("expected", types.String()),
+	structField("actual", types.String()),
+	structField("message", types.String()),
+}
+
 var Tenecs_json_JsonField = structWithFields("JsonField", tenecs_json_JsonField, tenecs_json_JsonField_Fields...)
 
 var tenecs_json_JsonField = types.Struct(
@@ -85,12 +160,27 @@ var tenecs_json_JsonField_Fields = []func(fields *StructWithFields){
 	}),
 }
 
+// decodeErrors reads the errors of a fromJson back from the details of its Error, an Error that doesn't have them being a single error at $.
+var tenecs_json_decodeErrors = functionFromType("(error: Error) ~> List<JsonDecodeError>", Tenecs_error_Error, Tenecs_json_JsonDecodeError)
+
 var tenecs_json_jsonList = functionFromType("<T>(of: JsonConverter<T>) ~> JsonConverter<List<T>>", Tenecs_json_JsonConverter)
 
 var tenecs_json_jsonBoolean = functionFromType("() ~> JsonConverter<Boolean>", Tenecs_json_JsonConverter)
 
+// jsonConverter is derived by the codegen from the type it's invoked with, which can be any struct, or-type or list made of them and the basic types other than Char.
+// The alternatives of an or-type have to be different kinds of json, except for structs, which are tagged with a "$type" when there are several.
+var tenecs_json_jsonConverter = functionFromType("<T>() ~> JsonConverter<T>", Tenecs_json_JsonConverter)
+
+// jsonFirstError keeps only the first of the errors of a fromJson, which otherwise has one for each field or element that failed.
+var tenecs_json_jsonFirstError = functionFromType("<T>(of: JsonConverter<T>) ~> JsonConverter<T>", Tenecs_json_JsonConverter)
+
+var tenecs_json_jsonFloat = functionFromType("() ~> JsonConverter<Float>", Tenecs_json_JsonConverter)
+
 var tenecs_json_jsonInt = functionFromType("() ~> JsonConverter<Int>", Tenecs_json_JsonConverter)
 
+// jsonNullable reads null as Void, and writes Void as null.
+var tenecs_json_jsonNullable = functionFromType("<T>(of: JsonConverter<T>) ~> JsonConverter<T | Void>", Tenecs_json_JsonConverter)
+
 var tenecs_json_jsonObject0 = functionFromType("<R>(build: () ~> R) ~> JsonConverter<R>", Tenecs_json_JsonConverter)
 
 var tenecs_json_jsonObject = func() []NamedFunction {
@@ -134,6 +224,15 @@ var tenecs_json_jsonObject = func() []NamedFunction {
 	return result
 }()
 
+// jsonOptional is for object fields that may be missing, which are then read as whenMissing.
+var tenecs_json_jsonOptional = functionFromType("<T>(of: JsonConverter<T>, whenMissing: T) ~> JsonConverter<T>", Tenecs_json_JsonConverter)
+
 var tenecs_json_jsonOr = functionFromType("<A, B>(ConverterA: JsonConverter<A>, ConverterB: JsonConverter<B>, toJsonConverterPicker: (A | B) ~> JsonConverter<A> | JsonConverter<B>) ~> JsonConverter<A | B>", Tenecs_json_JsonConverter)
 
 var tenecs_json_jsonString = functionFromType("() ~> JsonConverter<String>", Tenecs_json_JsonConverter)
+
+var tenecs_json_jsonValue = &types.Function{
+	Generics:   []string{},
+	Arguments:  []types.FunctionArgument{},
+	ReturnType: tenecs_json_JsonConverter_Of(tenecs_json_JsonValue),
+}
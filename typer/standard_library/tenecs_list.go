@@ -3,11 +3,16 @@ package standard_library
 import "github.com/xplosunn/tenecs/typer/types"
 
 var tenecs_list = packageWith(
+	withFunction("all", tenecs_list_all),
+	withFunction("any", tenecs_list_any),
 	withFunction("append", tenecs_list_append),
 	withFunction("appendAll", tenecs_list_appendAll),
 	withFunction("atIndexGet", tenecs_list_atIndexGet),
 	withFunction("atIndexSet", tenecs_list_atIndexSet),
 	withStruct(Tenecs_list_Break),
+	withFunction("chunked", tenecs_list_chunked),
+	withFunction("distinct", tenecs_list_distinct),
+	withFunction("drop", tenecs_list_drop),
 	withFunction("filter", tenecs_list_filter),
 	withFunction("find", tenecs_list_find),
 	withFunction("flatMap", tenecs_list_flatMap),
@@ -15,17 +20,44 @@ var tenecs_list = packageWith(
 	withFunction("fold", tenecs_list_fold),
 	withFunction("first", tenecs_list_first),
 	withFunction("forEach", tenecs_list_forEach),
+	withStruct(Tenecs_list_Group),
+	withFunction("groupBy", tenecs_list_groupBy),
+	withStruct(Tenecs_list_Indexed),
+	withFunction("indexed", tenecs_list_indexed),
+	withFunction("last", tenecs_list_last),
 	withFunction("length", tenecs_list_length),
 	withFunction("map", tenecs_list_map),
 	withFunction("mapUntil", tenecs_list_mapUntil),
 	withFunction("mapNotNull", tenecs_list_mapNotNull),
+	withFunction("max", tenecs_list_max),
+	withFunction("min", tenecs_list_min),
+	withStruct(Tenecs_list_Pair),
+	withStruct(Tenecs_list_Partition),
+	withFunction("partition", tenecs_list_partition),
+	withFunction("range", tenecs_list_range),
 	withFunction("repeat", tenecs_list_repeat),
+	withFunction("sortBy", tenecs_list_sortBy),
+	withFunction("sum", tenecs_list_sum),
+	withFunction("take", tenecs_list_take),
+	withFunction("zip", tenecs_list_zip),
 )
 
+var tenecs_list_all = functionFromType("<A>(list: List<A>, predicate: (A) ~> Boolean) ~> Boolean")
+
+var tenecs_list_any = functionFromType("<A>(list: List<A>, predicate: (A) ~> Boolean) ~> Boolean")
+
 var tenecs_list_append = functionFromType("<T>(list: List<T>, newElement: T) ~> List<T>")
 
 var tenecs_list_appendAll = functionFromType("<T>(list: List<T>, newElements: List<T>) ~> List<T>")
 
+// chunked splits the list into lists of the given size, the last one having whatever elements are left.
+var tenecs_list_chunked = functionFromType("<A>(list: List<A>, size: Int) ~> List<List<A>> | Error", Tenecs_error_Error)
+
+// distinct keeps the first of the elements that are equal, as in tenecs.compare.eq.
+var tenecs_list_distinct = functionFromType("<A>(list: List<A>) ~> List<A>")
+
+var tenecs_list_drop = functionFromType("<A>(list: List<A>, count: Int) ~> List<A>")
+
 var tenecs_list_filter = functionFromType("<A>(list: List<A>, keep: (A) ~> Boolean) ~> List<A>")
 
 var tenecs_list_find = functionFromType("<A, B>(list: List<A>, f: (A) ~> B | Void) ~> B | Void")
@@ -38,6 +70,13 @@ var tenecs_list_fold = functionFromType("<A, Acc>(list: List<A>, zero: Acc, f: (
 
 var tenecs_list_forEach = functionFromType("<A>(list: List<A>, f: (A) ~> Void) ~> Void")
 
+// groupBy keeps the groups in the order their keys first show up, and the elements of each group in the order they had.
+var tenecs_list_groupBy = functionFromType("<A, K>(list: List<A>, key: (A) ~> K) ~> List<Group<K, A>>", Tenecs_list_Group)
+
+var tenecs_list_indexed = functionFromType("<A>(list: List<A>) ~> List<Indexed<A>>", Tenecs_list_Indexed)
+
+var tenecs_list_last = functionFromType("<A>(list: List<A>) ~> A | Void")
+
 var tenecs_list_length = functionFromType("<T>(list: List<T>) ~> Int")
 
 var tenecs_list_map = functionFromType("<A, B>(list: List<A>, f: (A) ~> B) ~> List<B>")
@@ -46,8 +85,29 @@ var tenecs_list_mapUntil = functionFromType("<A, B, S>(list: List<A>, f: (A) ~>
 
 var tenecs_list_mapNotNull = functionFromType("<A, B>(list: List<A>, f: (A) ~> B | Void) ~> List<B>")
 
+// max is the last of the greatest elements, or Void for an empty list.
+var tenecs_list_max = functionFromType("<A>(list: List<A>, comparator: Comparator<A>) ~> A | Void", Tenecs_compare_Comparator)
+
+// min is the first of the smallest elements, or Void for an empty list.
+var tenecs_list_min = functionFromType("<A>(list: List<A>, comparator: Comparator<A>) ~> A | Void", Tenecs_compare_Comparator)
+
+// sortBy is stable, so elements the comparator finds equal keep their order.
+var tenecs_list_sortBy = functionFromType("<A>(list: List<A>, comparator: Comparator<A>) ~> List<A>", Tenecs_compare_Comparator)
+
+var tenecs_list_partition = functionFromType("<A>(list: List<A>, predicate: (A) ~> Boolean) ~> Partition<A>", Tenecs_list_Partition)
+
+// range is the numbers from the first one up to, but not including, the second one.
+var tenecs_list_range = functionFromType("(from: Int, until: Int) ~> List<Int>")
+
 var tenecs_list_repeat = functionFromType("<A>(elem: A, times: Int) ~> List<A>")
 
+var tenecs_list_sum = functionFromType("(list: List<Int>) ~> Int")
+
+var tenecs_list_take = functionFromType("<A>(list: List<A>, count: Int) ~> List<A>")
+
+// zip pairs up the elements at the same index, stopping at the end of the shorter list.
+var tenecs_list_zip = functionFromType("<A, B>(left: List<A>, right: List<B>) ~> List<Pair<A, B>>", Tenecs_list_Pair)
+
 var tenecs_list_first = functionFromType("<A>(list: List<A>) ~> A | Void")
 
 var tenecs_list_atIndexGet = functionFromType("<A>(list: List<A>, index: Int) ~> A | Error", Tenecs_error_Error)
@@ -61,3 +121,39 @@ var tenecs_list_Break = types.Struct("tenecs.list", "Break", []string{"S"})
 var tenecs_list_Break_Fields = []func(fields *StructWithFields){
 	structField("value", &types.TypeArgument{Name: "S"}),
 }
+
+var Tenecs_list_Group = structWithFields("Group", tenecs_list_Group, tenecs_list_Group_Fields...)
+
+var tenecs_list_Group = types.Struct("tenecs.list", "Group", []string{"K", "A"})
+
+var tenecs_list_Group_Fields = []func(fields *StructWithFields){
+	structField("key", &types.TypeArgument{Name: "K"}),
+	structField("values", &types.List{Generic: &types.TypeArgument{Name: "A"}}),
+}
+
+var Tenecs_list_Indexed = structWithFields("Indexed", tenecs_list_Indexed, tenecs_list_Indexed_Fields...)
+
+var tenecs_list_Indexed = types.Struct("tenecs.list", "Indexed", []string{"A"})
+
+var tenecs_list_Indexed_Fields = []func(fields *StructWithFields){
+	structField("index", types.Int()),
+	structField("value", &types.TypeArgument{Name: "A"}),
+}
+
+var Tenecs_list_Pair = structWithFields("Pair", tenecs_list_Pair, tenecs_list_Pair_Fields...)
+
+var tenecs_list_Pair = types.Struct("tenecs.list", "Pair", []string{"A", "B"})
+
+var tenecs_list_Pair_Fields = []func(fields *StructWithFields){
+	structField("left", &types.TypeArgument{Name: "A"}),
+	structField("right", &types.TypeArgument{Name: "B"}),
+}
+
+var Tenecs_list_Partition = structWithFields("Partition", tenecs_list_Partition, tenecs_list_Partition_Fields...)
+
+var tenecs_list_Partition = types.Struct("tenecs.list", "Partition", []string{"A"})
+
+var tenecs_list_Partition_Fields = []func(fields *StructWithFields){
+	structField("matching", &types.List{Generic: &types.TypeArgument{Name: "A"}}),
+	structField("notMatching", &types.List{Generic: &types.TypeArgument{Name: "A"}}),
+}
@@ -0,0 +1,136 @@
+package standard_library
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/xplosunn/tenecs/typer/types"
+)
+
+var tenecs_regex = packageWith(
+	withStruct(Tenecs_regex_Match),
+	withStruct(Tenecs_regex_Regex),
+	withFunction("compile", tenecs_regex_compile),
+	withFunction("find", tenecs_regex_find),
+	withFunction("findAll", tenecs_regex_findAll),
+	withFunction("matches", tenecs_regex_matches),
+	withFunction("replaceAll", tenecs_regex_replaceAll),
+)
+
+// Regex can only be made with compile, which makes sure the pattern works the same in Go and JS.
+// It keeps the compiled pattern, and the one anchored to the whole string that matches uses.
+var Tenecs_regex_Regex = opaqueStruct("Regex", tenecs_regex_Regex, "compiled", "anchored")
+
+var tenecs_regex_Regex = types.Struct(
+	"tenecs.regex",
+	"Regex",
+	nil,
+)
+
+var Tenecs_regex_Match = structWithFields("Match", tenecs_regex_Match, tenecs_regex_Match_Fields...)
+
+var tenecs_regex_Match = types.Struct(
+	"tenecs.regex",
+	"Match",
+	nil,
+)
+
+// groups has one element per capture group, which is Void when the group didn't take part in the match.
+var tenecs_regex_Match_Fields = []func(fields *StructWithFields){
+	structField("text", types.String()),
+	structField("groups", &types.List{Generic: &types.OrVariableType{
+		Elements: []types.VariableType{types.String(), types.Void()},
+	}}),
+}
+
+// compile is checked by the typer when the pattern is a literal, see RegexUnsupportedSyntax.
+var tenecs_regex_compile = functionFromType("(pattern: String) ~> Regex | Error", Tenecs_regex_Regex, Tenecs_error_Error)
+
+var tenecs_regex_find = functionFromType("(regex: Regex, str: String) ~> Match | Void", Tenecs_regex_Regex, Tenecs_regex_Match)
+
+// findAll skips empty matches right after a previous match.
+var tenecs_regex_findAll = functionFromType("(regex: Regex, str: String) ~> List<Match>", Tenecs_regex_Regex, Tenecs_regex_Match)
+
+// matches is whether the whole string matches, not just a part of it.
+var tenecs_regex_matches = functionFromType("(regex: Regex, str: String) ~> Boolean", Tenecs_regex_Regex)
+
+// replaceAll takes the replacement literally, so $ has no special meaning in it.
+var tenecs_regex_replaceAll = functionFromType("(regex: Regex, str: String, replacement: String) ~> String", Tenecs_regex_Regex)
+
+// RegexUnsupportedSyntax is what in the pattern is outside of the regex syntax that Go and JS agree on, or "" when there's nothing.
+// The runtimes of both codegens carry a copy of it for patterns that aren't literals.
+func RegexUnsupportedSyntax(pattern string) string {
+	runes := []rune(pattern)
+	inClass := false
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		next := func(offset int) rune {
+			if i+offset < len(runes) {
+				return runes[i+offset]
+			}
+			return 0
+		}
+		switch {
+		case c == '\\':
+			e := next(1)
+			i++
+			switch {
+			case e == 0:
+			case strings.ContainsRune(`^$\.*+?()[]{}|/`, e):
+			case strings.ContainsRune("dDwWntrfvs", e):
+			case e == 'S' && !inClass:
+			case (e == 'b' || e == 'B') && !inClass:
+			case e == '-' && inClass:
+			case e >= '0' && e <= '9':
+				return "backreferences"
+			case inClass:
+				return `the escape \` + string(e) + " inside a character class"
+			default:
+				return `the escape \` + string(e)
+			}
+		case inClass:
+			if c == '[' {
+				return "an unescaped [ inside a character class"
+			}
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			if next(1) == '^' {
+				i++
+			}
+			if next(1) == ']' {
+				return "an unescaped ] at the start of a character class"
+			}
+		case c == '(' && next(1) == '?':
+			if next(2) == ':' {
+				i += 2
+			} else if next(2) == '=' || next(2) == '!' || (next(2) == '<' && (next(3) == '=' || next(3) == '!')) {
+				return "lookarounds"
+			} else if next(2) == '<' || next(2) == 'P' {
+				return "named groups"
+			} else {
+				return "inline flags"
+			}
+		case c == '{':
+			end := i + 1
+			for end < len(runes) && (runes[end] >= '0' && runes[end] <= '9' || runes[end] == ',') {
+				end++
+			}
+			counts := strings.Split(string(runes[i+1:end]), ",")
+			if end == len(runes) || runes[end] != '}' || len(counts) > 2 || counts[0] == "" {
+				return "an unescaped {"
+			}
+			for _, count := range counts {
+				if n, err := strconv.Atoi(count); count != "" && (err != nil || n > 1000) {
+					return "a repetition count over 1000"
+				}
+			}
+			i = end
+		case c == ']' || c == '}':
+			return "an unescaped " + string(c)
+		}
+	}
+	return ""
+}
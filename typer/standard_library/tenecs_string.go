@@ -2,21 +2,31 @@ package standard_library
 
 var tenecs_string = packageWith(
 	withFunction("characters", tenecs_string_characters),
+	withFunction("chars", tenecs_string_chars),
 	withFunction("contains", tenecs_string_contains),
 	withFunction("endsWith", tenecs_string_endsWith),
 	withFunction("firstChar", tenecs_string_firstChar),
 	withFunction("firstCharCode", tenecs_string_firstCharCode),
+	withFunction("fromChars", tenecs_string_fromChars),
+	withFunction("fromInt", tenecs_string_fromInt),
+	withFunction("graphemes", tenecs_string_graphemes),
+	withFunction("indexOf", tenecs_string_indexOf),
 	withFunction("isBlank", tenecs_string_isBlank),
 	withFunction("isEmpty", tenecs_string_isEmpty),
 	withFunction("join", tenecs_string_join),
 	withFunction("length", tenecs_string_length),
+	withFunction("lines", tenecs_string_lines),
 	withFunction("padLeft", tenecs_string_padLeft),
 	withFunction("padRight", tenecs_string_padRight),
 	withFunction("repeat", tenecs_string_repeat),
+	withFunction("replace", tenecs_string_replace),
 	withFunction("reverse", tenecs_string_reverse),
+	withFunction("split", tenecs_string_split),
 	withFunction("startsWith", tenecs_string_startsWith),
 	withFunction("stripPrefix", tenecs_string_stripPrefix),
 	withFunction("stripSuffix", tenecs_string_stripSuffix),
+	withFunction("substring", tenecs_string_substring),
+	withFunction("toInt", tenecs_string_toInt),
 	withFunction("toLowerCase", tenecs_string_toLowerCase),
 	withFunction("toUpperCase", tenecs_string_toUpperCase),
 	withFunction("trim", tenecs_string_trim),
@@ -65,3 +75,35 @@ var tenecs_string_padRight = functionFromType("(str: String, length: Int, padCha
 var tenecs_string_firstCharCode = functionFromType("(str: String) ~> Int")
 
 var tenecs_string_firstChar = functionFromType("(str: String) ~> String")
+
+var tenecs_string_fromInt = functionFromType("(value: Int) ~> String")
+
+// indexOf counts unicode code points, like length, and is Void when subStr isn't found.
+var tenecs_string_indexOf = functionFromType("(str: String, subStr: String) ~> Int | Void")
+
+// lines splits on both "\n" and "\r\n", without an empty line at the end for a trailing line break.
+var tenecs_string_lines = functionFromType("(str: String) ~> List<String>")
+
+// replace replaces every occurrence of target.
+var tenecs_string_replace = functionFromType("(str: String, target: String, replacement: String) ~> String")
+
+// split with an empty separator splits the string into its characters.
+var tenecs_string_split = functionFromType("(str: String, separator: String) ~> List<String>")
+
+// substring goes from start up to, but not including, end, both counted in unicode code points and kept within the string.
+var tenecs_string_substring = functionFromType("(str: String, start: Int, end: Int) ~> String")
+
+// toInt accepts an optional sign followed by decimal digits.
+var tenecs_string_toInt = functionFromType("(str: String) ~> Int | Error", Tenecs_error_Error)
+
+// chars is the unicode code points of the string.
+var tenecs_string_chars = functionFromType("(str: String) ~> List<Char>")
+
+var tenecs_string_fromChars = functionFromType("(chars: List<Char>) ~> String")
+
+// graphemes keeps together what reads as a single character: combining marks with what they combine with,
+// emoji joined by zero width joiners or with modifiers, flags, and "\r\n".
+// It approximates the extended grapheme clusters of Unicode Standard Annex #29 the same way on every backend:
+// anything following a zero width joiner is kept with it, not only emoji, while prepended marks,
+// Hangul jamo sequences and Indic conjuncts are split apart.
+var tenecs_string_graphemes = functionFromType("(str: String) ~> List<String>")
@@ -4,6 +4,9 @@ import "github.com/xplosunn/tenecs/typer/types"
 
 var tenecs_test = packageWith(
 	withStruct(Tenecs_test_Assert),
+	withStruct(Tenecs_test_FakeClock),
+	withStruct(Tenecs_test_FakeConsole),
+	withStruct(Tenecs_test_FakeProcess),
 	withStruct(Tenecs_test_GoIntegrationTest),
 	withStruct(Tenecs_test_GoIntegrationTestKit),
 	withStruct(Tenecs_test_UnitTest),
@@ -48,6 +51,47 @@ var tenecs_test_Assert_Fields = []func(fields *StructWithFields){
 	}),
 }
 
+var Tenecs_test_FakeClock = structWithFields("FakeClock", &tenecs_test_FakeClock, tenecs_test_FakeClock_Fields...)
+
+var tenecs_test_FakeClock = types.KnownType{
+	Package: "tenecs.test",
+	Name:    "FakeClock",
+}
+
+var tenecs_test_FakeClock_Fields = []func(fields *StructWithFields){
+	structField("advance", functionFromType("(duration: Duration) ~> Void", Tenecs_time_Duration)),
+	structField("set", functionFromType("(instant: Instant) ~> Void", Tenecs_time_Instant)),
+	structField("time", &tenecs_go_Time),
+}
+
+var Tenecs_test_FakeConsole = structWithFields("FakeConsole", &tenecs_test_FakeConsole, tenecs_test_FakeConsole_Fields...)
+
+var tenecs_test_FakeConsole = types.KnownType{
+	Package: "tenecs.test",
+	Name:    "FakeConsole",
+}
+
+var tenecs_test_FakeConsole_Fields = []func(fields *StructWithFields){
+	structField("console", &tenecs_go_Console),
+	structField("setStdin", functionFromType("(input: String) ~> Void")),
+	structField("stderr", functionFromType("() ~> String")),
+	structField("stdout", functionFromType("() ~> String")),
+}
+
+var Tenecs_test_FakeProcess = structWithFields("FakeProcess", &tenecs_test_FakeProcess, tenecs_test_FakeProcess_Fields...)
+
+var tenecs_test_FakeProcess = types.KnownType{
+	Package: "tenecs.test",
+	Name:    "FakeProcess",
+}
+
+var tenecs_test_FakeProcess_Fields = []func(fields *StructWithFields){
+	structField("exitCode", functionFromType("() ~> Int | Void")),
+	structField("process", &tenecs_go_Process),
+	structField("setArgs", functionFromType("(args: List<String>) ~> Void")),
+	structField("setEnv", functionFromType("(name: String, value: String) ~> Void")),
+}
+
 var Tenecs_test_GoIntegrationTest = structWithFields("GoIntegrationTest", &tenecs_test_GoIntegrationTest, tenecs_test_GoIntegrationTest_Fields...)
 
 var tenecs_test_GoIntegrationTest = types.KnownType{
@@ -82,6 +126,12 @@ var tenecs_test_GoIntegrationTestKit = types.KnownType{
 
 var tenecs_test_GoIntegrationTestKit_Fields = []func(fields *StructWithFields){
 	structField("assert", &tenecs_test_Assert),
+	structField("fakeClock", &tenecs_test_FakeClock),
+	structField("fakeConcurrent", &tenecs_go_Concurrent),
+	structField("fakeConsole", &tenecs_test_FakeConsole),
+	structField("fakeFs", &tenecs_go_FileSystem),
+	structField("fakeHttp", functionFromType("(handler: (Request) ~> Response) ~> Http", Tenecs_http_Request, Tenecs_http_Response, Tenecs_go_Http)),
+	structField("fakeProcess", &tenecs_test_FakeProcess),
 }
 
 var Tenecs_test_UnitTestKit = structWithFields("UnitTestKit", &tenecs_test_UnitTestKit, tenecs_test_UnitTestKit_Fields...)
@@ -93,6 +143,7 @@ var tenecs_test_UnitTestKit = types.KnownType{
 
 var tenecs_test_UnitTestKit_Fields = []func(fields *StructWithFields){
 	structField("assert", &tenecs_test_Assert),
+	structField("fakeClock", &tenecs_test_FakeClock),
 	structField("ref", tenecs_ref_RefCreator),
 }
 
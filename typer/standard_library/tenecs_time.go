@@ -4,9 +4,30 @@ import "github.com/xplosunn/tenecs/typer/types"
 
 var tenecs_time = packageWith(
 	withStruct(Tenecs_time_Date),
+	withStruct(Tenecs_time_DateTime),
+	withStruct(Tenecs_time_Duration),
+	withStruct(Tenecs_time_Instant),
 	withFunction("atStartOfMonth", Tenecs_time_atStartOfMonth),
+	withFunction("dayOfWeek", Tenecs_time_dayOfWeek),
+	withFunction("daysBetween", Tenecs_time_daysBetween),
+	withFunction("durationBetween", Tenecs_time_durationBetween),
+	withFunction("format", Tenecs_time_format),
+	withFunction("fromDateTime", Tenecs_time_fromDateTime),
+	withFunction("hours", Tenecs_time_hours),
+	withFunction("instantIsAfter", Tenecs_time_instantIsAfter),
+	withFunction("instantIsBefore", Tenecs_time_instantIsBefore),
+	withFunction("isAfter", Tenecs_time_isAfter),
+	withFunction("isBefore", Tenecs_time_isBefore),
+	withFunction("jsonDate", Tenecs_time_jsonDate),
+	withFunction("minusDuration", Tenecs_time_minusDuration),
+	withFunction("minutes", Tenecs_time_minutes),
+	withFunction("parse", Tenecs_time_parse),
 	withFunction("plusDays", Tenecs_time_plusDays),
+	withFunction("plusDuration", Tenecs_time_plusDuration),
+	withFunction("plusMonths", Tenecs_time_plusMonths),
 	withFunction("plusYears", Tenecs_time_plusYears),
+	withFunction("seconds", Tenecs_time_seconds),
+	withFunction("toDateTime", Tenecs_time_toDateTime),
 )
 
 var Tenecs_time_Date = structWithFields("Date", tenecs_time_Date, tenecs_time_Date_Fields...)
@@ -23,8 +44,84 @@ var tenecs_time_Date_Fields = []func(fields *StructWithFields){
 	structField("day", types.Int()),
 }
 
+var Tenecs_time_DateTime = structWithFields("DateTime", tenecs_time_DateTime, tenecs_time_DateTime_Fields...)
+
+var tenecs_time_DateTime = types.Struct(
+	"tenecs.time",
+	"DateTime",
+	nil,
+)
+
+var tenecs_time_DateTime_Fields = []func(fields *StructWithFields){
+	structField("date", tenecs_time_Date),
+	structField("hour", types.Int()),
+	structField("minute", types.Int()),
+	structField("second", types.Int()),
+	structField("millisecond", types.Int()),
+}
+
+var Tenecs_time_Duration = structWithFields("Duration", tenecs_time_Duration, tenecs_time_Duration_Fields...)
+
+var tenecs_time_Duration = types.Struct(
+	"tenecs.time",
+	"Duration",
+	nil,
+)
+
+var tenecs_time_Duration_Fields = []func(fields *StructWithFields){
+	structField("milliseconds", types.Int()),
+}
+
+var Tenecs_time_Instant = structWithFields("Instant", tenecs_time_Instant, tenecs_time_Instant_Fields...)
+
+var tenecs_time_Instant = types.Struct(
+	"tenecs.time",
+	"Instant",
+	nil,
+)
+
+var tenecs_time_Instant_Fields = []func(fields *StructWithFields){
+	structField("epochMilliseconds", types.Int()),
+}
+
 var Tenecs_time_atStartOfMonth = functionFromType("(date: Date) ~> Date", Tenecs_time_Date)
 
 var Tenecs_time_plusDays = functionFromType("(date: Date, days: Int) ~> Date", Tenecs_time_Date)
 
+var Tenecs_time_plusMonths = functionFromType("(date: Date, months: Int) ~> Date", Tenecs_time_Date)
+
 var Tenecs_time_plusYears = functionFromType("(date: Date, years: Int) ~> Date", Tenecs_time_Date)
+
+var Tenecs_time_durationBetween = functionFromType("(from: Instant, to: Instant) ~> Duration", Tenecs_time_Instant, Tenecs_time_Duration)
+
+var Tenecs_time_fromDateTime = functionFromType("(dateTime: DateTime, timeZone: String) ~> Instant | Error", Tenecs_time_Date, Tenecs_time_DateTime, Tenecs_time_Instant, Tenecs_error_Error)
+
+var Tenecs_time_hours = functionFromType("(amount: Int) ~> Duration", Tenecs_time_Duration)
+
+var Tenecs_time_instantIsAfter = functionFromType("(instant: Instant, other: Instant) ~> Boolean", Tenecs_time_Instant)
+
+var Tenecs_time_instantIsBefore = functionFromType("(instant: Instant, other: Instant) ~> Boolean", Tenecs_time_Instant)
+
+var Tenecs_time_minusDuration = functionFromType("(instant: Instant, duration: Duration) ~> Instant", Tenecs_time_Instant, Tenecs_time_Duration)
+
+var Tenecs_time_minutes = functionFromType("(amount: Int) ~> Duration", Tenecs_time_Duration)
+
+var Tenecs_time_plusDuration = functionFromType("(instant: Instant, duration: Duration) ~> Instant", Tenecs_time_Instant, Tenecs_time_Duration)
+
+var Tenecs_time_seconds = functionFromType("(amount: Int) ~> Duration", Tenecs_time_Duration)
+
+var Tenecs_time_toDateTime = functionFromType("(instant: Instant, timeZone: String) ~> DateTime | Error", Tenecs_time_Date, Tenecs_time_DateTime, Tenecs_time_Instant, Tenecs_error_Error)
+
+var Tenecs_time_dayOfWeek = functionFromType("(date: Date) ~> Int", Tenecs_time_Date)
+
+var Tenecs_time_daysBetween = functionFromType("(from: Date, to: Date) ~> Int", Tenecs_time_Date)
+
+var Tenecs_time_format = functionFromType("(date: Date) ~> String", Tenecs_time_Date)
+
+var Tenecs_time_isAfter = functionFromType("(date: Date, other: Date) ~> Boolean", Tenecs_time_Date)
+
+var Tenecs_time_isBefore = functionFromType("(date: Date, other: Date) ~> Boolean", Tenecs_time_Date)
+
+var Tenecs_time_jsonDate = functionFromType("() ~> JsonConverter<Date>", Tenecs_time_Date, Tenecs_json_JsonConverter)
+
+var Tenecs_time_parse = functionFromType("(text: String) ~> Date | Error", Tenecs_time_Date, Tenecs_error_Error)
@@ -7,6 +7,8 @@ var tenecs_web = packageWith(
 	withStruct(Tenecs_web_WebApp),
 	withStruct(Tenecs_web_HtmlElement),
 	withStruct(Tenecs_web_HtmlElementProperty),
+	withFunction("httpGet", Tenecs_web_httpGet),
+	withFunction("httpPost", Tenecs_web_httpPost),
 )
 
 var Tenecs_web_CssUrl = structWithFields("CssUrl", tenecs_web_CssUrl, tenecs_web_CssUrl_Fields...)
@@ -119,3 +121,7 @@ var tenecs_web_HtmlElementProperty_Fields = []func(fields *StructWithFields){
 		},
 	}),
 }
+
+var Tenecs_web_httpGet = functionFromType("<Event>(url: String, onResult: (Response | Error) ~> Event) ~> Void", Tenecs_http_Response, Tenecs_error_Error)
+
+var Tenecs_web_httpPost = functionFromType("<Event>(url: String, contentType: String, body: String, onResult: (Response | Error) ~> Event) ~> Void", Tenecs_http_Response, Tenecs_error_Error)
@@ -0,0 +1,25 @@
+package parser_typer_test
+
+import "testing"
+
+func TestBigIntWithoutFromString(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.bigint.BigInt
+
+seven := BigInt("07")
+`, "Reference not found: BigInt")
+}
+
+func TestBigIntDigitsNotAccessible(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.bigint.BigInt
+
+digits := (value: BigInt): String => {
+  value.digits
+}
+`, "no field named digits on tenecs.bigint.BigInt")
+}
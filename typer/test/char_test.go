@@ -0,0 +1,21 @@
+package parser_typer_test
+
+import "testing"
+
+func TestCharLiteral(t *testing.T) {
+	validProgram(t, `
+package main
+
+letter: Char = 'a'
+emoji: Char = '😀'
+newline: Char = '\n'
+`)
+}
+
+func TestCharLiteralIsNotString(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+letter: String = 'a'
+`, "expected type String but found Char")
+}
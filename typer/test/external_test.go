@@ -0,0 +1,73 @@
+package parser_typer_test
+
+import (
+	"testing"
+)
+
+func TestExternalUnknownTarget(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+external reverse: (s: String) ~> String = rust("strutil", "reverse")
+`, "unknown external target rust (expected one of [go js])")
+}
+
+func TestExternalNotAFunction(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+external pi: Float = go("math", "Pi")
+`, "external declarations need to be functions")
+}
+
+func TestExternalUnsupportedArgumentType(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+struct Point(x: Int, y: Int)
+
+external distance: (p: Point) ~> Float = go("example.com/geo", "Distance")
+`, "external function argument p has unsupported type main.Point")
+}
+
+func TestExternalUnsupportedReturnType(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+external parse: (s: String) ~> Int | String = go("example.com/parse", "Parse")
+`, "external function has unsupported return type Int | String")
+}
+
+func TestExternalDuplicateName(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+external toUpper: (s: String) ~> String = go("strings", "ToUpper")
+
+toUpper := (s: String): String => {
+  s
+}
+`, "duplicate variable 'toUpper': (String) ~> String")
+}
+
+func TestMultiplePackagesExternalImport(t *testing.T) {
+	f1 := `
+package strutil
+
+import tenecs.error.Error
+
+external atoi: (s: String) ~> Int | Error = go("strconv", "Atoi")
+`
+	f2 := `
+package main
+
+import strutil.atoi
+import tenecs.error.Error
+
+parse := (s: String): Int | Error => {
+  atoi(s)
+}
+`
+	validProgramFromFileContents(t, []string{f1, f2})
+	validProgramFromFileContents(t, []string{f2, f1})
+}
@@ -0,0 +1,110 @@
+package parser_typer_test
+
+import "testing"
+
+func TestJsonConverterOfRecursiveStruct(t *testing.T) {
+	validProgram(t, `
+package main
+
+import tenecs.json.jsonConverter
+
+struct Tree(value: Int, children: List<Tree>)
+
+converter := jsonConverter<Tree>()
+`)
+}
+
+func TestJsonConverterOfTypeArgument(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.json.JsonConverter
+import tenecs.json.jsonConverter
+
+converterOf := <T>(): JsonConverter<T> => {
+  jsonConverter<T>()
+}
+`, "jsonConverter can't derive a converter for T")
+}
+
+func TestJsonConverterOfStructWithFunctionField(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.json.jsonConverter
+
+struct Handler(name: String, handle: (String) ~> Void)
+
+converter := jsonConverter<List<Handler | Void>>()
+`, "jsonConverter can't derive a converter for (String) ~> Void")
+}
+
+func TestJsonConverterOfRegex(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.json.jsonConverter
+import tenecs.regex.Regex
+
+converter := jsonConverter<Regex>()
+`, "jsonConverter can't derive a converter for tenecs.regex.Regex")
+}
+
+func TestJsonConverterOfChar(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.json.jsonConverter
+
+struct Initial(letter: Char)
+
+converter := jsonConverter<Initial>()
+`, "jsonConverter can't derive a converter for Char")
+}
+
+func TestJsonConverterOfOrWithStructs(t *testing.T) {
+	validProgram(t, `
+package main
+
+import tenecs.json.jsonConverter
+
+struct Circle(radius: Int)
+struct Square(side: Int)
+
+converter := jsonConverter<Circle | Square | List<Circle> | String | Int | Boolean | Void>()
+`)
+}
+
+func TestJsonConverterOfOrWithTwoNumbers(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.json.jsonConverter
+
+struct Reading(value: Int | Float)
+
+converter := jsonConverter<Reading>()
+`, "jsonConverter can't derive a converter for Int | Float since Int and Float would both be read from a json number")
+}
+
+func TestJsonConverterOfOrWithTwoLists(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.json.jsonConverter
+
+converter := jsonConverter<List<Int> | List<String>>()
+`, "jsonConverter can't derive a converter for List<Int> | List<String> since List<Int> and List<String> would both be read from a json array")
+}
+
+func TestJsonConverterOfOrWithSameStructTwice(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.json.jsonConverter
+
+struct Box<T>(content: T)
+
+converter := jsonConverter<Box<Int> | Box<String>>()
+`, "jsonConverter can't derive a converter for main.Box<Int> | main.Box<String> since main.Box<Int> and main.Box<String> would both be tagged \"Box\"")
+}
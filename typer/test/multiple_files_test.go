@@ -164,3 +164,33 @@ func invalidProgramFromFileContents(t *testing.T, fileContents []string, errorMe
 	assert.Error(t, typeErr, "Didn't get an typererror")
 	assert.Equal(t, errorMessage, typeErr.Error())
 }
+
+func TestMultiplePackagesCyclicImports(t *testing.T) {
+	f1 := `
+package colors
+
+import shapes.Square
+
+struct Red()
+`
+	f2 := `
+package shapes
+
+import colors.Red
+
+struct Square()
+`
+	invalidProgramFromFileContents(t, []string{f1, f2}, "cyclic package imports: colors -> shapes -> colors")
+	invalidProgramFromFileContents(t, []string{f2, f1}, "cyclic package imports: colors -> shapes -> colors")
+}
+
+func TestMultiplePackagesUnknownImport(t *testing.T) {
+	f1 := `
+package colors
+
+import shapes.Square
+
+struct Red()
+`
+	invalidProgramFromFileContents(t, []string{f1}, "failed to import shapes.Square as it was not found")
+}
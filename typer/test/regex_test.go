@@ -0,0 +1,77 @@
+package parser_typer_test
+
+import "testing"
+
+func TestRegexCompileLiteral(t *testing.T) {
+	validProgram(t, `
+package main
+
+import tenecs.regex.compile
+
+digits := compile("[0-9]+(?:\\.[0-9]+)?")
+`)
+}
+
+func TestRegexCompileLiteralWithLookaround(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.regex.compile
+
+notFollowedByB := compile("a(?!b)")
+`, "Regex pattern uses lookarounds, which isn't supported")
+}
+
+func TestRegexCompileLiteralWithBackreferenceInArrow(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.regex.compile
+
+repeated := "(a)\\1"->compile()
+`, "Regex pattern uses backreferences, which isn't supported")
+}
+
+func TestRegexCompileLiteralInvalid(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.regex.compile
+
+unclosed := compile("(a")
+`, "Invalid regex pattern: error parsing regexp: missing closing ): `(a`")
+}
+
+func TestRegexCompileNotLiteral(t *testing.T) {
+	validProgram(t, `
+package main
+
+import tenecs.regex.compile
+
+pattern := "(?i)a"
+insensitive := compile(pattern)
+`)
+}
+
+func TestRegexWithoutCompile(t *testing.T) {
+	invalidProgram(t, `
+package main
+
+import tenecs.regex.Regex
+
+lookbehind := Regex("(?<=a)b")
+`, "Reference not found: Regex")
+}
+
+func TestRegexAsType(t *testing.T) {
+	validProgram(t, `
+package main
+
+import tenecs.regex.Regex
+import tenecs.regex.matches
+
+matchesWhole := (regex: Regex, str: String): Boolean => {
+  regex->matches(str)
+}
+`)
+}
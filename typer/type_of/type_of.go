@@ -2,6 +2,8 @@ package type_of
 
 import (
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 
 	"github.com/xplosunn/tenecs/desugar"
 	"github.com/xplosunn/tenecs/parser"
@@ -105,6 +107,14 @@ func TypeOfExpression(expression desugar.Expression, file string, scope binding.
 				func(literal string) {
 					varType = types.String()
 				},
+				func(literal string) {
+					unquoted, unquoteErr := strconv.Unquote(literal)
+					if unquoteErr != nil || utf8.RuneCountInString(unquoted) != 1 {
+						err = type_error.PtrOnNodef(file, expression.Node, "Invalid char literal %s", literal)
+						return
+					}
+					varType = types.Char()
+				},
 				func(literal bool) {
 					varType = types.Boolean()
 				},
@@ -1,8 +1,11 @@
 package typer
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"unicode"
 
 	"github.com/xplosunn/tenecs/desugar"
@@ -27,6 +30,20 @@ func TypecheckSingleFile(parsed desugar.FileTopLevel) (*ast.Program, error) {
 }
 
 func TypecheckPackages(parsed map[string]desugar.FileTopLevel) (*ast.Program, error) {
+	return TypecheckPackagesWithCache(parsed, nil, nil)
+}
+
+// PackageCache stores the typechecked programs of single packages.
+// It's best effort: failing to store or load a package only means it gets typechecked.
+type PackageCache interface {
+	Get(key string) (*ast.Program, bool)
+	Put(key string, program *ast.Program)
+}
+
+// TypecheckPackagesWithCache typechecks only the packages missing from the cache, taking the rest as they were cached.
+// A package is keyed by the sources of its files (by file name) and the keys of the packages it imports,
+// so a change to a package invalidates the packages depending on it.
+func TypecheckPackagesWithCache(parsed map[string]desugar.FileTopLevel, sources map[string]string, cache PackageCache) (*ast.Program, error) {
 	byPackage := map[string]map[string]desugar.FileTopLevel{}
 	for file, parsedFile := range parsed {
 		pkg := ""
@@ -48,8 +65,27 @@ func TypecheckPackages(parsed map[string]desugar.FileTopLevel) (*ast.Program, er
 		NativeFunctions:               map[ast.Ref]*types.Function{},
 		FieldsByType:                  map[ast.Ref]map[string]types.VariableType{},
 		StructTypeArgumentMatchFields: map[ast.Ref][]string{},
+		ExternalFunctions:             map[ast.Ref]ast.ExternalFunction{},
+	}
+	exports := &OtherPackagesContext{
+		Declarations:    map[ast.Ref]types.VariableType{},
+		TypeAliases:     map[ast.Ref]ast.TypeAlias{},
+		StructFunctions: map[ast.Ref]*types.Function{},
+		FieldsByType:    map[ast.Ref]map[string]types.VariableType{},
 	}
 	typedPackages := []string{}
+	cacheKeys := map[string]string{}
+	if cache != nil && sources != nil {
+		cacheKeys = packageCacheKeys(byPackage, sources)
+		for pkgName, key := range cacheKeys {
+			cached, ok := cache.Get(key)
+			if ok {
+				addExports(exports, exportsOf(cached))
+				addPackageProgram(&program, cached)
+				typedPackages = append(typedPackages, pkgName)
+			}
+		}
+	}
 	for len(maps.Keys(byPackage)) > len(typedPackages) {
 		type PackageProgram struct {
 			Program *ast.Program
@@ -62,32 +98,26 @@ func TypecheckPackages(parsed map[string]desugar.FileTopLevel) (*ast.Program, er
 			}
 
 			dependencies := dependency.DependenciesOfSinglePackage(parsedPkg)
-			allDependenciesTyped := true
+			allDependenciesExported := true
 			for _, dep := range dependencies {
-				if !slices.Contains(typedPackages, dep) {
-					allDependenciesTyped = false
+				_, depIsInProgram := byPackage[dep]
+				if depIsInProgram && !slices.Contains(typedPackages, dep) {
+					allDependenciesExported = false
 					break
 				}
 			}
-			if !allDependenciesTyped {
+			if !allDependenciesExported {
 				continue
 			}
-			otherPackageDeclarations := map[ast.Ref]types.VariableType{}
-			for ref, expression := range program.Declarations {
-				otherPackageDeclarations[ref] = ast.VariableTypeOfExpression(expression)
-			}
-			otherPackageTypeAliases := map[ast.Ref]ast.TypeAlias{}
-			for ref, typeAlias := range program.TypeAliases {
-				otherPackageTypeAliases[ref] = typeAlias
+			otherPackagesContext := &OtherPackagesContext{
+				Declarations:    maps.Clone(exports.Declarations),
+				TypeAliases:     maps.Clone(exports.TypeAliases),
+				StructFunctions: maps.Clone(exports.StructFunctions),
+				FieldsByType:    maps.Clone(exports.FieldsByType),
 			}
 			pkg := pkgName
 			typedPackagesInThisLoop = append(typedPackagesInThisLoop, async.RunAsync(func() (PackageProgram, error) {
-				program, err := TypecheckSinglePackage(parsedPkg, &OtherPackagesContext{
-					Declarations:    otherPackageDeclarations,
-					TypeAliases:     otherPackageTypeAliases,
-					StructFunctions: program.StructFunctions,
-					FieldsByType:    program.FieldsByType,
-				})
+				program, err := TypecheckSinglePackage(parsedPkg, otherPackagesContext)
 				return PackageProgram{
 					Program: program,
 					Package: pkg,
@@ -100,33 +130,150 @@ func TypecheckPackages(parsed map[string]desugar.FileTopLevel) (*ast.Program, er
 				return nil, err
 			}
 			pkgProgram := packageProgramWrapper.Program
-			for ref, expression := range pkgProgram.Declarations {
-				program.Declarations[ref] = expression
-			}
-			for ref, typeAlias := range pkgProgram.TypeAliases {
-				program.TypeAliases[ref] = typeAlias
-			}
-			for ref, function := range pkgProgram.StructFunctions {
-				program.StructFunctions[ref] = function
-			}
-			for ref, function := range pkgProgram.NativeFunctions {
-				program.NativeFunctions[ref] = function
-			}
-			for ref, fields := range pkgProgram.FieldsByType {
-				program.FieldsByType[ref] = fields
-			}
-			for ref, fields := range pkgProgram.StructTypeArgumentMatchFields {
-				program.StructTypeArgumentMatchFields[ref] = fields
+			if key, ok := cacheKeys[packageProgramWrapper.Package]; ok {
+				cache.Put(key, pkgProgram)
 			}
+			addExports(exports, exportsOf(pkgProgram))
+			addPackageProgram(&program, pkgProgram)
 			typedPackages = append(typedPackages, packageProgramWrapper.Package)
 		}
 		if len(typedPackagesInThisLoop) == 0 {
-			panic("circular dependencies detected (todo nicer error here)")
+			return nil, cyclicImportsError(byPackage, typedPackages)
 		}
 	}
 	return &program, nil
 }
 
+func exportsOf(program *ast.Program) *OtherPackagesContext {
+	result := &OtherPackagesContext{
+		Declarations:    map[ast.Ref]types.VariableType{},
+		TypeAliases:     program.TypeAliases,
+		StructFunctions: program.StructFunctions,
+		FieldsByType:    program.FieldsByType,
+	}
+	for ref, expression := range program.Declarations {
+		result.Declarations[ref] = ast.VariableTypeOfExpression(expression)
+	}
+	for ref, external := range program.ExternalFunctions {
+		result.Declarations[ref] = external.VariableType
+	}
+	return result
+}
+
+func addPackageProgram(program *ast.Program, pkgProgram *ast.Program) {
+	maps.Copy(program.Declarations, pkgProgram.Declarations)
+	maps.Copy(program.TypeAliases, pkgProgram.TypeAliases)
+	maps.Copy(program.StructFunctions, pkgProgram.StructFunctions)
+	maps.Copy(program.NativeFunctions, pkgProgram.NativeFunctions)
+	maps.Copy(program.FieldsByType, pkgProgram.FieldsByType)
+	maps.Copy(program.StructTypeArgumentMatchFields, pkgProgram.StructTypeArgumentMatchFields)
+	maps.Copy(program.ExternalFunctions, pkgProgram.ExternalFunctions)
+}
+
+func addExports(exports *OtherPackagesContext, pkgExports *OtherPackagesContext) {
+	maps.Copy(exports.Declarations, pkgExports.Declarations)
+	maps.Copy(exports.TypeAliases, pkgExports.TypeAliases)
+	maps.Copy(exports.StructFunctions, pkgExports.StructFunctions)
+	maps.Copy(exports.FieldsByType, pkgExports.FieldsByType)
+}
+
+// packageCacheKeys has no key for the packages in an import cycle.
+func packageCacheKeys(byPackage map[string]map[string]desugar.FileTopLevel, sources map[string]string) map[string]string {
+	cacheKeys := map[string]string{}
+	visiting := map[string]bool{}
+	var keyOf func(pkgName string) (string, bool)
+	keyOf = func(pkgName string) (string, bool) {
+		if key, ok := cacheKeys[pkgName]; ok {
+			return key, true
+		}
+		if visiting[pkgName] {
+			return "", false
+		}
+		visiting[pkgName] = true
+		defer delete(visiting, pkgName)
+		parsedPkg := byPackage[pkgName]
+		dependencies := dependency.DependenciesOfSinglePackage(parsedPkg)
+		dependencyKeys := map[string]string{}
+		for _, dep := range dependencies {
+			if _, depIsInProgram := byPackage[dep]; !depIsInProgram {
+				continue
+			}
+			key, ok := keyOf(dep)
+			if !ok {
+				return "", false
+			}
+			dependencyKeys[dep] = key
+		}
+		cacheKeys[pkgName] = packageCacheKey(pkgName, parsedPkg, sources, dependencies, dependencyKeys)
+		return cacheKeys[pkgName], true
+	}
+	for pkgName := range byPackage {
+		keyOf(pkgName)
+	}
+	return cacheKeys
+}
+
+func packageCacheKey(pkgName string, parsedPkg map[string]desugar.FileTopLevel, sources map[string]string, dependencies []string, cacheKeys map[string]string) string {
+	hash := sha256.New()
+	writeField := func(s string) {
+		hash.Write([]byte(strconv.Itoa(len(s))))
+		hash.Write([]byte{':'})
+		hash.Write([]byte(s))
+	}
+	writeField(pkgName)
+	files := maps.Keys(parsedPkg)
+	slices.Sort(files)
+	for _, file := range files {
+		writeField(file)
+		writeField(sources[file])
+	}
+	sortedDependencies := append([]string{}, dependencies...)
+	slices.Sort(sortedDependencies)
+	for _, dependency := range sortedDependencies {
+		writeField(dependency)
+		writeField(cacheKeys[dependency])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func cyclicImportsError(byPackage map[string]map[string]desugar.FileTopLevel, typedPackages []string) *type_error.TypecheckError {
+	dependenciesByPackage := map[string][]string{}
+	for pkgName, parsedPkg := range byPackage {
+		if !slices.Contains(typedPackages, pkgName) {
+			dependenciesByPackage[pkgName] = dependency.DependenciesOfSinglePackage(parsedPkg)
+		}
+	}
+	cycle := dependency.FindCycle(dependenciesByPackage)
+	if cycle == nil {
+		panic("no package could be typechecked but no cyclic imports were found")
+	}
+	message := "cyclic package imports: "
+	for i, pkg := range cycle {
+		if i > 0 {
+			message += " -> "
+		}
+		message += pkg
+	}
+
+	files := maps.Keys(byPackage[cycle[0]])
+	slices.Sort(files)
+	for _, file := range files {
+		for _, node := range byPackage[cycle[0]][file].Imports {
+			importedPkg := ""
+			for i, name := range node.DotSeparatedVars[:len(node.DotSeparatedVars)-1] {
+				if i > 0 {
+					importedPkg += "."
+				}
+				importedPkg += name.String
+			}
+			if importedPkg == cycle[1] {
+				return type_error.PtrOnNodef(file, node.Node, "%s", message)
+			}
+		}
+	}
+	return type_error.PtrOnNodef(files[0], parser.Node{}, "%s", message)
+}
+
 type OtherPackagesContext struct {
 	Declarations    map[ast.Ref]types.VariableType
 	TypeAliases     map[ast.Ref]ast.TypeAlias
@@ -197,11 +344,13 @@ func TypecheckSinglePackage(parsedPackage map[string]desugar.FileTopLevel, other
 	structsPerFile := map[string][]desugar.Struct{}
 	declarationsPerFile := map[string][]desugar.Declaration{}
 	typeAliasesInAllFiles := map[string][]desugar.TypeAlias{}
+	externalsPerFile := map[string][]desugar.External{}
 	for file, fileTopLevel := range parsedPackage {
-		declarations, structs, typeAliases := splitTopLevelDeclarations(fileTopLevel.TopLevelDeclarations)
+		declarations, structs, typeAliases, externals := splitTopLevelDeclarations(fileTopLevel.TopLevelDeclarations)
 		structsPerFile[file] = structs
 		declarationsPerFile[file] = declarations
 		typeAliasesInAllFiles[file] = typeAliases
+		externalsPerFile[file] = externals
 	}
 
 	programStructFunctions, programTypeAliases, scope, err := validateStructsAndTypeAliases(structsPerFile, typeAliasesInAllFiles, pkgName, scope)
@@ -209,6 +358,13 @@ func TypecheckSinglePackage(parsedPackage map[string]desugar.FileTopLevel, other
 		return nil, err
 	}
 	program.StructFunctions = programStructFunctions
+
+	programExternalFunctions, scope, err := validateExternals(externalsPerFile, pkgName, scope)
+	if err != nil {
+		return nil, err
+	}
+	program.ExternalFunctions = programExternalFunctions
+
 	programFieldsByType := binding.GetAllFields(scope)
 	for name, fieldsMap := range programFieldsByType {
 		program.FieldsByType[ast.Ref{
@@ -269,10 +425,11 @@ func validatePackage(node desugar.Package, file string) *type_error.TypecheckErr
 	return nil
 }
 
-func splitTopLevelDeclarations(topLevelDeclarations []desugar.TopLevelDeclaration) ([]desugar.Declaration, []desugar.Struct, []desugar.TypeAlias) {
+func splitTopLevelDeclarations(topLevelDeclarations []desugar.TopLevelDeclaration) ([]desugar.Declaration, []desugar.Struct, []desugar.TypeAlias, []desugar.External) {
 	declarations := []desugar.Declaration{}
 	structs := []desugar.Struct{}
 	typeAliases := []desugar.TypeAlias{}
+	externals := []desugar.External{}
 	for _, topLevelDeclaration := range topLevelDeclarations {
 		desugar.TopLevelDeclarationExhaustiveSwitch(
 			topLevelDeclaration,
@@ -285,13 +442,19 @@ func splitTopLevelDeclarations(topLevelDeclarations []desugar.TopLevelDeclaratio
 			func(topLevelDeclaration desugar.TypeAlias) {
 				typeAliases = append(typeAliases, topLevelDeclaration)
 			},
+			func(topLevelDeclaration desugar.External) {
+				externals = append(externals, topLevelDeclaration)
+			},
 		)
 	}
-	return declarations, structs, typeAliases
+	return declarations, structs, typeAliases, externals
 }
 
 func addAllStructFieldsToScope(file string, scope binding.Scope, pkg standard_library.Package) binding.Scope {
 	for structName, structWithFields := range pkg.Structs {
+		if structWithFields.Opaque {
+			continue
+		}
 		var err *binding.ResolutionError
 		scope, err = binding.CopyAddingFields(scope, structWithFields.Struct.Package, desugar.Name{
 			String: structName,
@@ -450,12 +613,16 @@ func resolveImports(nodes []desugar.Import, stdLib standard_library.Package, oth
 				if err != nil {
 					return nil, nil, nil, type_error.FromResolutionError(file, fallbackOnNil(as, name).Node, err)
 				}
+				if struc.Opaque {
+					scope = updatedScope
+					continue
+				}
 				updatedScope, err = binding.CopyAddingFields(updatedScope, currPackageName, fallbackOnNil(as, name), struc.Fields)
 				if err != nil {
 					return nil, nil, nil, type_error.FromResolutionError(file, fallbackOnNil(as, name).Node, err)
 				}
 				constructorArguments := []types.FunctionArgument{}
-				for _, structFieldName := range struc.FieldNamesSorted {
+				for _, structFieldName := range struc.ConstructorFieldNames {
 					constructorArguments = append(constructorArguments, types.FunctionArgument{
 						Name:         structFieldName,
 						VariableType: struc.Fields[structFieldName],
@@ -524,6 +691,16 @@ func resolveImports(nodes []desugar.Import, stdLib standard_library.Package, oth
 				continue
 			}
 
+			typeAliasToImport, ok := currPackage.TypeAliases[name.String]
+			if ok {
+				updatedScope, err := binding.CopyAddingTypeAliasToFile(scope, file, fallbackOnNil(as, name), []string{}, typeAliasToImport)
+				if err != nil {
+					return nil, nil, nil, type_error.FromResolutionError(file, fallbackOnNil(as, name).Node, err)
+				}
+				scope = updatedScope
+				continue
+			}
+
 			return nil, nil, nil, type_error.PtrOnNodef(file, name.Node, "didn't find "+name.String+" while importing")
 		}
 	}
@@ -68,6 +68,7 @@ func String() *KnownType  { return basicType("String") }
 func Float() *KnownType   { return basicType("Float") }
 func Int() *KnownType     { return basicType("Int") }
 func Boolean() *KnownType { return basicType("Boolean") }
+func Char() *KnownType    { return basicType("Char") }
 func Void() *KnownType    { return basicType("Void") }
 
 func basicType(name string) *KnownType {